@@ -3,28 +3,85 @@ package main
 import (
 	"context"
 	"fmt"
+	"mediajerk/backend/artwork"
 	"mediajerk/backend/non"
+	"mediajerk/backend/settings"
+	"mediajerk/backend/tmdb"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/wailsapp/wails/v2/pkg/runtime"
 )
 
 // App struct
 type App struct {
-	ctx context.Context
+	ctx       context.Context
+	tmdb      *tmdb.Client
+	artwork   *artwork.Store
+	orderings *settings.Orderings
+
+	// lookupCtx is derived from ctx and cancelled by CancelLookups,
+	// so in-flight TMDB requests can be abandoned
+	lookupMu     sync.Mutex
+	lookupCtx    context.Context
+	cancelLookup context.CancelFunc
+
+	// Resolvers are kept per series, so seasons fetched to find one episode are reused for the next
+	resolverMu sync.Mutex
+	airDates   map[int]*tmdb.AirDateResolver
+	absolutes  map[int]*tmdb.AbsoluteResolver
+	ordered    map[int]*tmdb.OrderingResolver
 }
 
-// NewApp creates a new App application struct
+// NewApp creates a new App application struct.
+// The TMDB API read access token or v3 API key is taken from the TMDB_API_KEY environment variable.
 func NewApp() *App {
-	return &App{}
+	key := os.Getenv("TMDB_API_KEY")
+	opts := []tmdb.Option{tmdb.WithUserAgent("mediajerk")}
+
+	// v4 read access tokens are JWTs, v3 API keys are 32 hex chars
+	if len(key) == 32 {
+		opts = append(opts, tmdb.WithAuthMode(tmdb.APIKeyAuth))
+	}
+
+	if dir, err := tmdb.DefaultCacheDir(); err == nil {
+		if cache, err := tmdb.NewDiskCache(dir); err == nil {
+			opts = append(opts, tmdb.WithCache(cache))
+		}
+	}
+
+	app := &App{
+		tmdb: tmdb.NewClient(key, opts...),
+	}
+
+	if dir, err := artwork.DefaultDir(); err == nil {
+		app.artwork, _ = artwork.NewStore(dir, artwork.DefaultMaxBytes, app.tmdb)
+	}
+
+	if dir, err := settings.DefaultDir(); err == nil {
+		app.orderings, _ = settings.LoadOrderings(filepath.Join(dir, "orderings.json"))
+	}
+
+	return app
 }
 
 // startup is called when the app starts. The context is saved
 // so we can call the runtime methods
 func (a *App) startup(ctx context.Context) {
 	a.ctx = ctx
+	a.CancelLookups()
+}
+
+// shutdown is called when the app is closing, it abandons any in-flight lookups
+func (a *App) shutdown(ctx context.Context) {
+	a.lookupMu.Lock()
+	defer a.lookupMu.Unlock()
+
+	if a.cancelLookup != nil {
+		a.cancelLookup()
+	}
 }
 
 // Greet returns a greeting for the given name
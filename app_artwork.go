@@ -0,0 +1,62 @@
+package main
+
+import (
+	"errors"
+	"mediajerk/backend/artwork"
+	"mediajerk/backend/tmdb"
+	"net/http"
+	"strconv"
+)
+
+var errNoArtwork = errors.New("artwork cache unavailable")
+
+// artworkHandler serves cached artwork to the webview, see artwork.Store.ServeHTTP
+func (a *App) artworkHandler() http.Handler {
+	if a.artwork == nil {
+		return http.NotFoundHandler()
+	}
+
+	return a.artwork
+}
+
+// ArtworkURL returns the local URL of a TMDB image path, eg. Movie.PosterPath,
+// at the smallest available size at least width pixels wide.
+// The image is downloaded and cached when the webview first loads it.
+func (a *App) ArtworkURL(path string, kind tmdb.ImageKind, width int) (string, error) {
+	config, err := a.tmdb.ImageConfig(a.lookups())
+	if err != nil {
+		return "", err
+	}
+
+	return artwork.URL(path, config.NearestSize(kind, width, tmdb.AtLeast)), nil
+}
+
+// SaveMovieArtwork saves a movie's poster and fanart into dir, returning the saved files
+func (a *App) SaveMovieArtwork(movieId int, dir string) ([]string, error) {
+	if a.artwork == nil {
+		return nil, errNoArtwork
+	}
+
+	ctx := a.lookups()
+	movie, err := a.tmdb.MoviesContext(ctx, strconv.Itoa(movieId), tmdb.DetailsParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	return a.artwork.SaveMovie(ctx, dir, movie)
+}
+
+// SaveSeriesArtwork saves a series' poster, fanart and season posters into dir, returning the saved files
+func (a *App) SaveSeriesArtwork(seriesId int, dir string) ([]string, error) {
+	if a.artwork == nil {
+		return nil, errNoArtwork
+	}
+
+	ctx := a.lookups()
+	series, err := a.tmdb.TVSeriesContext(ctx, strconv.Itoa(seriesId), tmdb.DetailsParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	return a.artwork.SaveSeries(ctx, dir, series)
+}
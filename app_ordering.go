@@ -0,0 +1,85 @@
+package main
+
+import (
+	"errors"
+	"mediajerk/backend/tmdb"
+	"strconv"
+)
+
+var errNoSettings = errors.New("settings unavailable")
+
+// EpisodeOrderings lists the orderings a series can be matched in, eg. default, dvd or storyarc
+func (a *App) EpisodeOrderings(seriesId int) ([]string, error) {
+	orderings, err := a.tmdb.OrderingsContext(a.lookups(), strconv.Itoa(seriesId))
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(orderings))
+	for i, ordering := range orderings {
+		names[i] = ordering.String()
+	}
+
+	return names, nil
+}
+
+// EpisodeOrdering returns the ordering chosen for a series, default when none was
+func (a *App) EpisodeOrdering(seriesId int) string {
+	if a.orderings == nil {
+		return tmdb.DefaultGrouping.String()
+	}
+
+	return a.orderings.Get(seriesId).String()
+}
+
+// SetEpisodeOrdering remembers the ordering a series' files are numbered in, eg. dvd,
+// so their episodes are matched by it from then on
+func (a *App) SetEpisodeOrdering(seriesId int, ordering string) error {
+	if a.orderings == nil {
+		return errNoSettings
+	}
+
+	grouping, err := tmdb.ParseGrouping(ordering)
+	if err != nil {
+		return err
+	}
+
+	if err := a.orderings.Set(seriesId, grouping); err != nil {
+		return err
+	}
+
+	a.resolverMu.Lock()
+	delete(a.ordered, seriesId)
+	a.resolverMu.Unlock()
+
+	return nil
+}
+
+// orderedResolver returns the resolver for a series' chosen ordering, or nil when it's the default
+func (a *App) orderedResolver(seriesId int) *tmdb.OrderingResolver {
+	if a.orderings == nil {
+		return nil
+	}
+
+	grouping := a.orderings.Get(seriesId)
+	if grouping == tmdb.DefaultGrouping {
+		return nil
+	}
+
+	a.resolverMu.Lock()
+	defer a.resolverMu.Unlock()
+
+	if a.ordered == nil {
+		a.ordered = map[int]*tmdb.OrderingResolver{}
+	}
+
+	// The ordering can change between reading it and taking the lock, so a resolver
+	// cached for another one is replaced rather than trusted
+	resolver, ok := a.ordered[seriesId]
+	if !ok || resolver.Grouping() != grouping {
+		resolver = a.tmdb.NewOrderingResolver(strconv.Itoa(seriesId), grouping)
+		a.ordered[seriesId] = resolver
+	}
+
+	return resolver
+}
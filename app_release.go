@@ -0,0 +1,8 @@
+package main
+
+import "mediajerk/backend/release"
+
+// ParseRelease reads the title, episode and release tags from a file name, eg. FileInfo.Name
+func (a *App) ParseRelease(name string) release.Info {
+	return release.Parse(name)
+}
@@ -0,0 +1,411 @@
+package main
+
+import (
+	"context"
+	"mediajerk/backend/tmdb"
+	"strconv"
+	"time"
+)
+
+// CancelLookups cancels all in-flight TMDB requests,
+// eg. when the file list is cleared
+func (a *App) CancelLookups() {
+	a.lookupMu.Lock()
+	defer a.lookupMu.Unlock()
+
+	if a.cancelLookup != nil {
+		a.cancelLookup()
+	}
+
+	a.lookupCtx, a.cancelLookup = context.WithCancel(a.ctx)
+}
+
+// SetOffline toggles serving TMDB lookups only from the local cache
+func (a *App) SetOffline(offline bool) {
+	a.tmdb.SetOffline(offline)
+}
+
+// lookups returns the context TMDB requests should be made with
+func (a *App) lookups() context.Context {
+	a.lookupMu.Lock()
+	defer a.lookupMu.Unlock()
+
+	return a.lookupCtx
+}
+
+// SearchMovie searches TMDB for movies matching the given params
+func (a *App) SearchMovie(params tmdb.MovieSearchParams) (*tmdb.SearchResponse[tmdb.Movie], error) {
+	ctx := a.lookups()
+	resp, err := a.tmdb.SearchMovieContext(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Genre names are a nicety, don't fail the search without them
+	a.tmdb.EnrichMovies(ctx, params.Language, resp.Results)
+	return resp, nil
+}
+
+// SearchTV searches TMDB for TV series matching the given params
+func (a *App) SearchTV(params tmdb.TVSearchParams) (*tmdb.SearchResponse[tmdb.TVShow], error) {
+	ctx := a.lookups()
+	resp, err := a.tmdb.SearchTVContext(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	a.tmdb.EnrichTVShows(ctx, params.Language, resp.Results)
+	return resp, nil
+}
+
+// SearchMulti searches TMDB for movies, TV series and people in a single request
+func (a *App) SearchMulti(params tmdb.CommonSearchParams) (*tmdb.SearchResponse[tmdb.MultiMedia], error) {
+	ctx := a.lookups()
+	resp, err := a.tmdb.SearchMultiContext(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	a.tmdb.EnrichMulti(ctx, params.Language, resp.Results)
+	return resp, nil
+}
+
+// DiscoverMovie browses TMDB for movies matching the given filters
+func (a *App) DiscoverMovie(params tmdb.DiscoverMovieParams) (*tmdb.SearchResponse[tmdb.Movie], error) {
+	ctx := a.lookups()
+	resp, err := a.tmdb.DiscoverMovieContext(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	a.tmdb.EnrichMovies(ctx, params.Language, resp.Results)
+	return resp, nil
+}
+
+// DiscoverTV browses TMDB for TV series matching the given filters
+func (a *App) DiscoverTV(params tmdb.DiscoverTVParams) (*tmdb.SearchResponse[tmdb.TVShow], error) {
+	ctx := a.lookups()
+	resp, err := a.tmdb.DiscoverTVContext(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	a.tmdb.EnrichTVShows(ctx, params.Language, resp.Results)
+	return resp, nil
+}
+
+// Trending lists what's trending on TMDB over the given window
+func (a *App) Trending(mediaType tmdb.TrendingMediaType, window tmdb.TimeWindow, params tmdb.TrendingParams) (*tmdb.SearchResponse[tmdb.MultiMedia], error) {
+	ctx := a.lookups()
+	resp, err := a.tmdb.TrendingContext(ctx, mediaType, window, params)
+	if err != nil {
+		return nil, err
+	}
+
+	a.tmdb.EnrichMulti(ctx, params.Language, resp.Results)
+	return resp, nil
+}
+
+// SearchPerson searches TMDB for people matching the given params
+func (a *App) SearchPerson(params tmdb.CommonSearchParams) (*tmdb.SearchResponse[tmdb.Person], error) {
+	return a.tmdb.SearchPersonContext(a.lookups(), params)
+}
+
+// Person fetches the details of a single person
+func (a *App) Person(personId int, params tmdb.DetailsParams) (*tmdb.PersonDetails, error) {
+	return a.tmdb.PersonContext(a.lookups(), strconv.Itoa(personId), params)
+}
+
+// PersonCredits fetches the movies and series a person worked on in department with job,
+// eg. Directing and Director, either may be empty to match any
+func (a *App) PersonCredits(personId int, department string, job string) ([]tmdb.PersonCredit, error) {
+	credits, err := a.tmdb.PersonCombinedCreditsContext(a.lookups(), strconv.Itoa(personId), tmdb.CreditsParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	return credits.Filter(department, job), nil
+}
+
+// Movie fetches the details of a single movie
+func (a *App) Movie(movieId int, params tmdb.DetailsParams) (*tmdb.MovieDetails, error) {
+	return a.tmdb.MoviesContext(a.lookups(), strconv.Itoa(movieId), params)
+}
+
+// MovieAlternativeTitles fetches a movie's titles in other countries, optionally only for country
+func (a *App) MovieAlternativeTitles(movieId int, country string) (*tmdb.AlternativeTitlesResponse, error) {
+	return a.tmdb.MovieAlternativeTitlesContext(a.lookups(), strconv.Itoa(movieId), tmdb.AlternativeTitlesParams{Country: country})
+}
+
+// TVSeriesAlternativeTitles fetches a series' names in other countries
+func (a *App) TVSeriesAlternativeTitles(seriesId int) (*tmdb.AlternativeTitlesResponse, error) {
+	return a.tmdb.TVSeriesAlternativeTitlesContext(a.lookups(), strconv.Itoa(seriesId))
+}
+
+// MovieTranslations fetches a movie's translated titles and overviews
+func (a *App) MovieTranslations(movieId int) (*tmdb.TranslationsResponse[tmdb.MovieTranslationData], error) {
+	return a.tmdb.MovieTranslationsContext(a.lookups(), strconv.Itoa(movieId))
+}
+
+// TVSeriesTranslations fetches a series' translated names and overviews
+func (a *App) TVSeriesTranslations(seriesId int) (*tmdb.TranslationsResponse[tmdb.TVTranslationData], error) {
+	return a.tmdb.TVSeriesTranslationsContext(a.lookups(), strconv.Itoa(seriesId))
+}
+
+// MovieCertification picks a movie's certification in country, eg. PG-13 in US,
+// or an empty string if it has none there
+func (a *App) MovieCertification(movieId int, country string) (string, error) {
+	releases, err := a.tmdb.MovieReleaseDatesContext(a.lookups(), strconv.Itoa(movieId))
+	if err != nil {
+		return "", err
+	}
+
+	return releases.Certification(country), nil
+}
+
+// TVSeriesContentRating picks a series' content rating in country, eg. TV-MA in US,
+// or an empty string if it has none there
+func (a *App) TVSeriesContentRating(seriesId int, country string) (string, error) {
+	ratings, err := a.tmdb.TVSeriesContentRatingsContext(a.lookups(), strconv.Itoa(seriesId))
+	if err != nil {
+		return "", err
+	}
+
+	return ratings.Rating(country), nil
+}
+
+// MovieKeywords fetches the keywords a movie is tagged with
+func (a *App) MovieKeywords(movieId int) (*tmdb.KeywordsResponse, error) {
+	return a.tmdb.MovieKeywordsContext(a.lookups(), strconv.Itoa(movieId))
+}
+
+// TVSeriesKeywords fetches the keywords a series is tagged with
+func (a *App) TVSeriesKeywords(seriesId int) (*tmdb.KeywordsResponse, error) {
+	return a.tmdb.TVSeriesKeywordsContext(a.lookups(), strconv.Itoa(seriesId))
+}
+
+// MovieWatchProviders fetches where a movie can be streamed, rented or bought in country
+func (a *App) MovieWatchProviders(movieId int, country string) (*tmdb.CountryWatchProviders, error) {
+	providers, err := a.tmdb.MovieWatchProvidersContext(a.lookups(), strconv.Itoa(movieId))
+	if err != nil {
+		return nil, err
+	}
+
+	return providers.Country(country), nil
+}
+
+// TVSeriesWatchProviders fetches where a series can be streamed, rented or bought in country
+func (a *App) TVSeriesWatchProviders(seriesId int, country string) (*tmdb.CountryWatchProviders, error) {
+	providers, err := a.tmdb.TVSeriesWatchProvidersContext(a.lookups(), strconv.Itoa(seriesId))
+	if err != nil {
+		return nil, err
+	}
+
+	return providers.Country(country), nil
+}
+
+// Collection fetches a movie collection and its parts
+func (a *App) Collection(collectionId int, params tmdb.CollectionParams) (*tmdb.CollectionDetails, error) {
+	return a.tmdb.CollectionContext(a.lookups(), strconv.Itoa(collectionId), params)
+}
+
+// SearchCollection searches TMDB for movie collections matching the given params
+func (a *App) SearchCollection(params tmdb.CollectionSearchParams) (*tmdb.SearchResponse[tmdb.Collection], error) {
+	return a.tmdb.SearchCollectionContext(a.lookups(), params)
+}
+
+// MovieCollection is the collection a movie belongs to and its position in it
+type MovieCollection struct {
+	Collection *tmdb.CollectionDetails `json:"collection"`
+	Part       int                     `json:"part"` // 1 based, in release order
+}
+
+// MovieCollection fetches the collection a movie belongs to, or nil if it doesn't belong to one
+func (a *App) MovieCollection(movieId int, language string) (*MovieCollection, error) {
+	ctx := a.lookups()
+	movie, err := a.tmdb.MoviesContext(ctx, strconv.Itoa(movieId), tmdb.DetailsParams{Language: language})
+	if err != nil || movie.BelongsToCollection == nil {
+		return nil, err
+	}
+
+	collection, err := a.tmdb.CollectionContext(ctx, strconv.Itoa(movie.BelongsToCollection.ID), tmdb.CollectionParams{Language: language})
+	if err != nil {
+		return nil, err
+	}
+
+	return &MovieCollection{collection, collection.PartNumber(movieId)}, nil
+}
+
+// TVSeries fetches the details of a single TV series
+func (a *App) TVSeries(seriesId int, params tmdb.DetailsParams) (*tmdb.TVSeriesDetails, error) {
+	return a.tmdb.TVSeriesContext(a.lookups(), strconv.Itoa(seriesId), params)
+}
+
+// TVSeason fetches a season of a TV series, including its episodes
+func (a *App) TVSeason(seriesId int, seasonNum int, params tmdb.DetailsParams) (*tmdb.TVSeasonDetails, error) {
+	return a.tmdb.TVSeasonContext(a.lookups(), strconv.Itoa(seriesId), seasonNum, params)
+}
+
+// TVEpisode fetches a single episode of a TV series, including its guest stars and crew.
+// The numbers are taken in the series' chosen ordering, see SetEpisodeOrdering.
+func (a *App) TVEpisode(seriesId int, seasonNum int, episodeNum int, params tmdb.DetailsParams) (*tmdb.EpisodeDetails, error) {
+	ctx := a.lookups()
+	if resolver := a.orderedResolver(seriesId); resolver != nil {
+		episode, err := resolver.ResolveContext(ctx, seasonNum, episodeNum)
+		if err != nil {
+			return nil, err
+		}
+
+		seasonNum, episodeNum = episode.SeasonNumber, episode.EpisodeNumber
+	}
+
+	return a.tmdb.TVEpisodeContext(ctx, strconv.Itoa(seriesId), seasonNum, episodeNum, params)
+}
+
+// TVEpisodesByAirDate finds the episodes of a daily show that aired on airDate, formatted YYYY-MM-DD
+func (a *App) TVEpisodesByAirDate(seriesId int, airDate string) ([]tmdb.Episode, error) {
+	date, err := time.Parse(time.DateOnly, airDate)
+	if err != nil {
+		return nil, err
+	}
+
+	a.resolverMu.Lock()
+	if a.airDates == nil {
+		a.airDates = map[int]*tmdb.AirDateResolver{}
+	}
+
+	resolver, ok := a.airDates[seriesId]
+	if !ok {
+		resolver = a.tmdb.NewAirDateResolver(strconv.Itoa(seriesId))
+		a.airDates[seriesId] = resolver
+	}
+	a.resolverMu.Unlock()
+
+	return resolver.ResolveContext(a.lookups(), date)
+}
+
+// TVEpisodeByAbsolute finds the episode of a series numbered absolute, as anime fansubs number them
+func (a *App) TVEpisodeByAbsolute(seriesId int, absolute int) (*tmdb.Episode, error) {
+	a.resolverMu.Lock()
+	if a.absolutes == nil {
+		a.absolutes = map[int]*tmdb.AbsoluteResolver{}
+	}
+
+	resolver, ok := a.absolutes[seriesId]
+	if !ok {
+		resolver = a.tmdb.NewAbsoluteResolver(strconv.Itoa(seriesId))
+		a.absolutes[seriesId] = resolver
+	}
+	a.resolverMu.Unlock()
+
+	return resolver.ResolveContext(a.lookups(), absolute)
+}
+
+// TVEpisodes fetches the episodes of a multi episode file, eg. S01E01-E03, in the order given.
+// The numbers are taken in the series' chosen ordering, see SetEpisodeOrdering.
+func (a *App) TVEpisodes(seriesId int, seasonNum int, episodeNums []int, params tmdb.DetailsParams) ([]tmdb.Episode, error) {
+	resolver := a.orderedResolver(seriesId)
+	if resolver == nil {
+		return a.tmdb.TVEpisodesContext(a.lookups(), strconv.Itoa(seriesId), seasonNum, episodeNums, params)
+	}
+
+	// Resolve to TMDB's numbering, which can spread the episodes over more than one season
+	type ref struct{ season, episode int }
+	ctx := a.lookups()
+	refs := make([]ref, len(episodeNums))
+	seasons := map[int][]int{}
+	var order []int
+	for i, num := range episodeNums {
+		episode, err := resolver.ResolveContext(ctx, seasonNum, num)
+		if err != nil {
+			return nil, err
+		}
+
+		refs[i] = ref{episode.SeasonNumber, episode.EpisodeNumber}
+		if _, ok := seasons[episode.SeasonNumber]; !ok {
+			order = append(order, episode.SeasonNumber)
+		}
+		seasons[episode.SeasonNumber] = append(seasons[episode.SeasonNumber], episode.EpisodeNumber)
+	}
+
+	fetched := make(map[ref]tmdb.Episode, len(refs))
+	for _, season := range order {
+		episodes, err := a.tmdb.TVEpisodesContext(ctx, strconv.Itoa(seriesId), season, seasons[season], params)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, episode := range episodes {
+			fetched[ref{season, seasons[season][i]}] = episode
+		}
+	}
+
+	episodes := make([]tmdb.Episode, len(refs))
+	for i, r := range refs {
+		episodes[i] = fetched[r]
+	}
+
+	return episodes, nil
+}
+
+// FindByExternalID looks up movies, series, seasons, episodes and people by their ID on another site,
+// eg. an IMDb tt ID
+func (a *App) FindByExternalID(externalId string, source tmdb.ExternalSource) (*tmdb.FindResponse, error) {
+	return a.tmdb.FindContext(a.lookups(), externalId, tmdb.FindParams{ExternalSource: source})
+}
+
+// MovieExternalIDs fetches the IDs of a movie on other sites
+func (a *App) MovieExternalIDs(movieId int) (*tmdb.ExternalIDsResponse, error) {
+	return a.tmdb.MovieExternalIDsContext(a.lookups(), strconv.Itoa(movieId))
+}
+
+// TVSeriesExternalIDs fetches the IDs of a TV series on other sites
+func (a *App) TVSeriesExternalIDs(seriesId int) (*tmdb.ExternalIDsResponse, error) {
+	return a.tmdb.TVSeriesExternalIDsContext(a.lookups(), strconv.Itoa(seriesId))
+}
+
+// EpisodeGroups lists the alternative episode orderings of a TV series
+func (a *App) EpisodeGroups(seriesId int) (*tmdb.EpisodeGroupList, error) {
+	return a.tmdb.EpisodeGroupsContext(a.lookups(), strconv.Itoa(seriesId))
+}
+
+// EpisodesGroupedBy fetches the episodes of a TV series arranged by an episode group
+func (a *App) EpisodesGroupedBy(groupId string) (*tmdb.EpisodeGroupDetails, error) {
+	return a.tmdb.EpisodesGroupedByContext(a.lookups(), groupId)
+}
+
+// ImageURL builds the full URL of a TMDB image path, eg. Movie.PosterPath,
+// at the smallest available size at least width pixels wide
+func (a *App) ImageURL(path string, kind tmdb.ImageKind, width int) (string, error) {
+	return a.tmdb.ImageURL(a.lookups(), path, kind, width, tmdb.AtLeast)
+}
+
+// MovieGenres lists the movie genres, with names in language
+func (a *App) MovieGenres(language string) ([]tmdb.Genre, error) {
+	genres, err := a.tmdb.MovieGenresContext(a.lookups(), tmdb.GenreListParams{Language: language})
+	if err != nil {
+		return nil, err
+	}
+
+	return genres.Genres, nil
+}
+
+// TVGenres lists the TV genres, with names in language
+func (a *App) TVGenres(language string) ([]tmdb.Genre, error) {
+	genres, err := a.tmdb.TVGenresContext(a.lookups(), tmdb.GenreListParams{Language: language})
+	if err != nil {
+		return nil, err
+	}
+
+	return genres.Genres, nil
+}
+
+// Languages lists the languages used throughout TMDB
+func (a *App) Languages() ([]tmdb.SpokenLanguage, error) {
+	return a.tmdb.LanguagesContext(a.lookups())
+}
+
+// Countries lists the countries used throughout TMDB, with native names in language
+func (a *App) Countries(language string) ([]tmdb.Country, error) {
+	return a.tmdb.CountriesContext(a.lookups(), tmdb.CountryListParams{Language: language})
+}
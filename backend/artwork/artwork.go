@@ -0,0 +1,289 @@
+package artwork
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mediajerk/backend/fsutil"
+	"mediajerk/backend/tmdb"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultMaxBytes bounds the disk cache when no size is given
+const DefaultMaxBytes = 512 << 20
+
+var (
+	sizeRegex = regexp.MustCompile(`^(w\d+|h\d+|original)$`)
+	fileRegex = regexp.MustCompile(`^[A-Za-z0-9_-]+\.(jpg|jpeg|png|svg|webp)$`)
+)
+
+var ErrInvalidImage = errors.New("artwork: invalid image path or size")
+
+// Store downloads TMDB images into a size-bounded disk cache,
+// sharing a single download between concurrent requests for the same image
+type Store struct {
+	dir      string
+	maxBytes int64
+	tmdb     *tmdb.Client
+	http     *http.Client
+
+	mu       sync.Mutex // Guards inflight and the size fields below
+	inflight map[string]*download
+	bytes    int64 // Cached total, as of the last eviction walk plus downloads since
+	counted  bool  // Whether bytes is known, it isn't until the first walk or after a Clear
+	evicting bool
+	cleared  int // Clears so far, so a walk that raced one doesn't set bytes
+}
+
+type download struct {
+	done chan struct{}
+	err  error
+}
+
+// DefaultDir returns the artwork cache directory inside the user's cache dir
+func DefaultDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "mediajerk", "artwork"), nil
+}
+
+// NewStore creates a Store caching into dir, holding up to maxBytes of images.
+// The tmdb client is only used for the image configuration.
+func NewStore(dir string, maxBytes int64, client *tmdb.Client) (*Store, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxBytes
+	}
+
+	return &Store{
+		dir:      dir,
+		maxBytes: maxBytes,
+		tmdb:     client,
+		http:     &http.Client{Timeout: time.Minute},
+		inflight: map[string]*download{},
+	}, nil
+}
+
+// localPath validates an image path and size, returning where the image is cached
+func (s *Store) localPath(imagePath string, size string) (string, error) {
+	name := filepath.Base(imagePath)
+	if !sizeRegex.MatchString(size) || !fileRegex.MatchString(name) {
+		return "", ErrInvalidImage
+	}
+
+	return filepath.Join(s.dir, size, name), nil
+}
+
+// Fetch returns the local path of a TMDB image path, eg. Movie.PosterPath, at a size like w500,
+// downloading it if it isn't cached yet
+func (s *Store) Fetch(ctx context.Context, imagePath string, size string) (string, error) {
+	local, err := s.localPath(imagePath, size)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := os.Stat(local); err == nil {
+		// Bump the modified time, eviction removes the least recently used first
+		now := time.Now()
+		os.Chtimes(local, now, now)
+		return local, nil
+	}
+
+	s.mu.Lock()
+	dl, ok := s.inflight[local]
+	if !ok {
+		// It may have finished downloading since it was looked for
+		if _, err := os.Stat(local); err == nil {
+			s.mu.Unlock()
+			return local, nil
+		}
+
+		dl = &download{done: make(chan struct{})}
+		s.inflight[local] = dl
+
+		// Detached from ctx, so one caller giving up doesn't fail the others
+		go func() {
+			dl.err = s.download(context.WithoutCancel(ctx), imagePath, size, local)
+
+			s.mu.Lock()
+			delete(s.inflight, local)
+			s.mu.Unlock()
+			close(dl.done)
+		}()
+	}
+	s.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case <-dl.done:
+		if dl.err != nil {
+			return "", dl.err
+		}
+
+		return local, nil
+	}
+}
+
+// FetchSized is like Fetch, but picks the size from TMDB's size lists for kind and width,
+// see tmdb.ImageConfiguration.NearestSize
+func (s *Store) FetchSized(ctx context.Context, imagePath string, kind tmdb.ImageKind, width int) (string, error) {
+	config, err := s.tmdb.ImageConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return s.Fetch(ctx, imagePath, config.NearestSize(kind, width, tmdb.AtLeast))
+}
+
+func (s *Store) download(ctx context.Context, imagePath string, size string, local string) error {
+	config, err := s.tmdb.ImageConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", config.SizedURL(imagePath, size), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("artwork: %s/%s: download failed with status %d", size, imagePath, resp.StatusCode)
+	}
+
+	var n int64
+	err = fsutil.WriteAtomic(local, func(w io.Writer) (err error) {
+		n, err = io.Copy(w, resp.Body)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.added(local, n)
+}
+
+// added counts n newly cached bytes, evicting once the cache is over maxBytes.
+// Only one eviction walks the cache at a time, downloads meanwhile are counted after it.
+func (s *Store) added(keep string, n int64) error {
+	s.mu.Lock()
+	s.bytes += n
+	if s.evicting || (s.counted && s.bytes <= s.maxBytes) {
+		s.mu.Unlock()
+		return nil
+	}
+
+	s.evicting = true
+	before, cleared := s.bytes, s.cleared
+	s.mu.Unlock()
+
+	remaining, err := s.evict(keep)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.evicting = false
+	if err == nil && cleared == s.cleared {
+		s.bytes = remaining + s.bytes - before
+		s.counted = true
+	}
+
+	return err
+}
+
+// evict removes the least recently used images until the cache fits in maxBytes,
+// keeping the just downloaded keep, and returns the size of what's left.
+// Downloads still being written are neither counted nor removed.
+func (s *Store) evict(keep string) (int64, error) {
+	type cached struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+
+	var files []cached
+	var total int64
+	err := filepath.WalkDir(s.dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || strings.HasPrefix(entry.Name(), fsutil.TempPrefix) {
+			return err
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil
+		}
+
+		files = append(files, cached{path, info.Size(), info.ModTime()})
+		total += info.Size()
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if total <= s.maxBytes {
+		return total, nil
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].modTime.Before(files[j].modTime)
+	})
+
+	for _, file := range files {
+		if total <= s.maxBytes {
+			break
+		}
+
+		if file.path == keep {
+			continue
+		}
+
+		if err := os.Remove(file.path); err == nil {
+			total -= file.size
+		}
+	}
+
+	return total, nil
+}
+
+// Clear removes every cached image
+func (s *Store) Clear() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return err
+	}
+
+	s.bytes, s.counted = 0, false
+	s.cleared++
+	for _, entry := range entries {
+		if err := os.RemoveAll(filepath.Join(s.dir, entry.Name())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package artwork
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mediajerk/backend/tmdb"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const imageSize = 100
+
+// testServer serves the TMDB configuration and an imageSize byte image for any other path,
+// counting image requests. Images are held back until release is closed, if it's set.
+type testServer struct {
+	requests atomic.Int32
+	release  chan struct{}
+	server   *httptest.Server
+}
+
+func newTestStore(t *testing.T, maxBytes int64, release chan struct{}) (*Store, *testServer) {
+	t.Helper()
+
+	ts := &testServer{release: release}
+	ts.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/configuration" {
+			fmt.Fprintf(w, `{"images": {"secure_base_url": %q, "poster_sizes": ["w92", "w342", "original"]}}`, ts.server.URL+"/t/p/")
+			return
+		}
+
+		ts.requests.Add(1)
+		if ts.release != nil {
+			<-ts.release
+		}
+
+		if strings.HasSuffix(r.URL.Path, "/missing.jpg") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Write([]byte(strings.Repeat("x", imageSize)))
+	}))
+	t.Cleanup(ts.server.Close)
+
+	client := tmdb.NewClient("key", tmdb.WithBaseURL(ts.server.URL), tmdb.WithRateLimit(0, 0))
+	store, err := NewStore(t.TempDir(), maxBytes, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return store, ts
+}
+
+func TestFetchSharesDownload(t *testing.T) {
+	release := make(chan struct{})
+	store, ts := newTestStore(t, 0, release)
+
+	var wg sync.WaitGroup
+	paths := make([]string, 5)
+	for i := range paths {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			path, err := store.Fetch(context.Background(), "/poster.jpg", "w342")
+			if err != nil {
+				t.Errorf("Fetch: %v", err)
+			}
+			paths[i] = path
+		}()
+	}
+
+	for ts.requests.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+
+	want := filepath.Join(store.dir, "w342", "poster.jpg")
+	for _, path := range paths {
+		if path != want {
+			t.Errorf("Fetch() = %s, want %s", path, want)
+		}
+	}
+
+	// Once cached it's served from disk
+	if _, err := store.Fetch(context.Background(), "/poster.jpg", "w342"); err != nil {
+		t.Fatal(err)
+	}
+
+	if ts.requests.Load() != 1 {
+		t.Errorf("made %d image requests, want 1", ts.requests.Load())
+	}
+}
+
+func TestFetchSized(t *testing.T) {
+	store, _ := newTestStore(t, 0, nil)
+
+	path, err := store.FetchSized(context.Background(), "/poster.jpg", tmdb.PosterImage, 300)
+	if err != nil || path != filepath.Join(store.dir, "w342", "poster.jpg") {
+		t.Errorf("FetchSized() = %s, %v, want the w342 poster", path, err)
+	}
+}
+
+func TestFetchInvalid(t *testing.T) {
+	store, ts := newTestStore(t, 0, nil)
+
+	for _, tt := range [][2]string{{"/../../etc/passwd", "w342"}, {"/poster.jpg", "../w342"}, {"/poster.exe", "w342"}} {
+		if _, err := store.Fetch(context.Background(), tt[0], tt[1]); !errors.Is(err, ErrInvalidImage) {
+			t.Errorf("Fetch(%s, %s) err = %v, want ErrInvalidImage", tt[0], tt[1], err)
+		}
+	}
+
+	if _, err := store.Fetch(context.Background(), "/missing.jpg", "w342"); err == nil {
+		t.Error("Fetch() of a missing image succeeded")
+	}
+
+	if _, err := os.Stat(filepath.Join(store.dir, "w342", "missing.jpg")); err == nil {
+		t.Error("failed download was cached")
+	}
+
+	if ts.requests.Load() != 1 {
+		t.Errorf("made %d image requests, want only the missing one", ts.requests.Load())
+	}
+}
+
+func TestEviction(t *testing.T) {
+	store, _ := newTestStore(t, 2*imageSize+imageSize/2, nil)
+	ctx := context.Background()
+
+	fetch := func(name string) string {
+		t.Helper()
+
+		path, err := store.Fetch(ctx, "/"+name, "w92")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return path
+	}
+
+	a, b := fetch("a.jpg"), fetch("b.jpg")
+	if store.bytes != 2*imageSize || !store.counted {
+		t.Fatalf("tracking %d bytes, counted %v, want %d counted", store.bytes, store.counted, 2*imageSize)
+	}
+
+	// Using a again makes b the least recently used
+	os.Chtimes(a, time.Now().Add(-2*time.Hour), time.Now().Add(-2*time.Hour))
+	os.Chtimes(b, time.Now().Add(-time.Hour), time.Now().Add(-time.Hour))
+	fetch("a.jpg")
+
+	c := fetch("c.jpg")
+	for path, want := range map[string]bool{a: true, b: false, c: true} {
+		if _, err := os.Stat(path); (err == nil) != want {
+			t.Errorf("%s cached = %v, want %v", filepath.Base(path), err == nil, want)
+		}
+	}
+
+	if store.bytes != 2*imageSize {
+		t.Errorf("tracking %d bytes after eviction, want %d", store.bytes, 2*imageSize)
+	}
+
+	if err := store.Clear(); err != nil {
+		t.Fatal(err)
+	}
+
+	if store.bytes != 0 || store.counted {
+		t.Errorf("tracking %d bytes, counted %v after Clear, want none uncounted", store.bytes, store.counted)
+	}
+
+	if _, err := os.Stat(a); err == nil {
+		t.Error("a.jpg still cached after Clear")
+	}
+}
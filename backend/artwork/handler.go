@@ -0,0 +1,54 @@
+package artwork
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// Prefix is the URL path the Store's handler serves images under
+const Prefix = "/artwork/"
+
+// URL returns the local URL the webview can load a TMDB image path from at size, eg. /artwork/w500/abc.jpg
+func URL(imagePath string, size string) string {
+	if imagePath == "" {
+		return ""
+	}
+
+	return Prefix + size + "/" + strings.TrimPrefix(imagePath, "/")
+}
+
+// ServeHTTP serves images from the cache at Prefix/{size}/{file}, downloading them on first use.
+// Other paths are answered with 404, so the Store can be used as the Wails asset server handler.
+func (s *Store) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rest, ok := strings.CutPrefix(r.URL.Path, Prefix)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	size, name, ok := strings.Cut(rest, "/")
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	local, err := s.Fetch(r.Context(), name, size)
+	switch {
+	case errors.Is(err, ErrInvalidImage):
+		http.NotFound(w, r)
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	// TMDB image paths are content addressed, they never change
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	http.ServeFile(w, r, local)
+}
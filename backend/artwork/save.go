@@ -0,0 +1,156 @@
+package artwork
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mediajerk/backend/fsutil"
+	"mediajerk/backend/tmdb"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// SaveSize is the size of images saved next to media, full resolution since media centres scale them down themselves
+const SaveSize = "original"
+
+// Save copies a TMDB image into dest, downloading it if needed.
+// dest gets the image's extension if it doesn't have one.
+func (s *Store) Save(ctx context.Context, imagePath string, dest string) (string, error) {
+	local, err := s.Fetch(ctx, imagePath, SaveSize)
+	if err != nil {
+		return "", err
+	}
+
+	if filepath.Ext(dest) == "" {
+		dest += filepath.Ext(local)
+	}
+
+	return dest, copyFile(local, dest)
+}
+
+// SaveMovie saves a movie's poster.jpg and fanart.jpg into dir, the Kodi and Plex local artwork names
+func (s *Store) SaveMovie(ctx context.Context, dir string, movie *tmdb.MovieDetails) ([]string, error) {
+	var saved []string
+
+	poster, backdrop := movie.PosterPath, movie.BackdropPath
+	if movie.Images != nil {
+		poster = bestPath(movie.Images.Posters, movie.OriginalLanguage, poster)
+		backdrop = bestPath(movie.Images.Backdrops, "", backdrop)
+	}
+
+	for name, imagePath := range map[string]*string{"poster": poster, "fanart": backdrop} {
+		if imagePath == nil || *imagePath == "" {
+			continue
+		}
+
+		dest, err := s.Save(ctx, *imagePath, filepath.Join(dir, name))
+		if err != nil {
+			return saved, err
+		}
+		saved = append(saved, dest)
+	}
+
+	sort.Strings(saved)
+	return saved, nil
+}
+
+// SaveSeries saves a series' poster.jpg and fanart.jpg, and a seasonNN-poster.jpg per season, into dir
+func (s *Store) SaveSeries(ctx context.Context, dir string, series *tmdb.TVSeriesDetails) ([]string, error) {
+	var saved []string
+
+	poster, backdrop := series.PosterPath, series.BackdropPath
+	if series.Images != nil {
+		poster = bestPath(series.Images.Posters, series.OriginalLanguage, poster)
+		backdrop = bestPath(series.Images.Backdrops, "", backdrop)
+	}
+
+	images := map[string]*string{"poster": poster, "fanart": backdrop}
+	for _, season := range series.Seasons {
+		images[SeasonPosterName(season.SeasonNumber)] = season.PosterPath
+	}
+
+	for name, imagePath := range images {
+		if imagePath == nil || *imagePath == "" {
+			continue
+		}
+
+		dest, err := s.Save(ctx, *imagePath, filepath.Join(dir, name))
+		if err != nil {
+			return saved, err
+		}
+		saved = append(saved, dest)
+	}
+
+	sort.Strings(saved)
+	return saved, nil
+}
+
+// SaveEpisode saves an episode's still as the -thumb image of the media file at mediaPath,
+// eg. Show S01E01.mkv gets Show S01E01-thumb.jpg
+func (s *Store) SaveEpisode(ctx context.Context, mediaPath string, episode *tmdb.Episode) (string, error) {
+	if episode.StillPath == nil || *episode.StillPath == "" {
+		return "", nil
+	}
+
+	base := strings.TrimSuffix(mediaPath, filepath.Ext(mediaPath))
+	return s.Save(ctx, *episode.StillPath, base+"-thumb")
+}
+
+// SeasonPosterName is the local artwork name of a season's poster, season 0 being specials
+func SeasonPosterName(seasonNum int) string {
+	if seasonNum == 0 {
+		return "season-specials-poster"
+	}
+
+	return fmt.Sprintf("season%02d-poster", seasonNum)
+}
+
+// BestImage picks the highest voted image, preferring those in language,
+// then those without any text, then any other. Returns nil if images is empty.
+func BestImage(images []tmdb.Image, language string) *tmdb.Image {
+	rank := func(img *tmdb.Image) int {
+		switch {
+		case img.ISO639_1 != nil && *img.ISO639_1 == language && language != "":
+			return 2
+		case img.ISO639_1 == nil || *img.ISO639_1 == "":
+			return 1
+		}
+		return 0
+	}
+
+	var best *tmdb.Image
+	for i := range images {
+		img := &images[i]
+		if best == nil || rank(img) > rank(best) ||
+			(rank(img) == rank(best) && img.VoteAverage > best.VoteAverage) {
+			best = img
+		}
+	}
+
+	return best
+}
+
+// bestPath returns the path of the BestImage, or fallback if there are no images
+func bestPath(images []tmdb.Image, language string, fallback *string) *string {
+	if best := BestImage(images, language); best != nil {
+		return &best.FilePath
+	}
+
+	return fallback
+}
+
+// copyFile copies src to dest through a temp file, so an interrupted save never leaves a truncated image
+func copyFile(src string, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	return fsutil.WriteAtomic(dest, func(w io.Writer) error {
+		_, err := io.Copy(w, in)
+		return err
+	})
+}
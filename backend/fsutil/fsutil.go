@@ -0,0 +1,44 @@
+package fsutil
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// TempPrefix starts the names of files still being written by WriteAtomic,
+// so anything walking the directory can skip them
+const TempPrefix = ".tmp-"
+
+// WriteAtomic writes path through write, into a temp file beside it that's then renamed over it,
+// so readers never see a partial file, nor does a crash leave one. The directory is created if needed.
+func WriteAtomic(path string, write func(w io.Writer) error) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), TempPrefix+"*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := write(tmp); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// WriteFile is WriteAtomic for data already in memory
+func WriteFile(path string, data []byte) error {
+	return WriteAtomic(path, func(w io.Writer) error {
+		_, err := w.Write(data)
+		return err
+	})
+}
@@ -0,0 +1,36 @@
+package fsutil
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteAtomic(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dir", "file.json")
+
+	if err := WriteFile(path, []byte("first")); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	// A failed write leaves the old file and no temp file behind
+	failed := errors.New("failed")
+	err := WriteAtomic(path, func(w io.Writer) error {
+		w.Write([]byte("partial"))
+		return failed
+	})
+	if !errors.Is(err, failed) {
+		t.Fatalf("WriteAtomic err = %v, want the write's error", err)
+	}
+
+	if data, _ := os.ReadFile(path); string(data) != "first" {
+		t.Errorf("file holds %q, want first", data)
+	}
+
+	entries, _ := os.ReadDir(filepath.Dir(path))
+	if len(entries) != 1 {
+		t.Errorf("dir holds %d files, want only file.json", len(entries))
+	}
+}
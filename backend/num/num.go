@@ -45,3 +45,15 @@ func MaxOf[T cmp.Ordered](args ...T) T {
 
 	return a
 }
+
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~float32 | ~float64
+}
+
+func Abs[T Signed](x T) T {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
@@ -0,0 +1,568 @@
+package release
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Field names a part of a release name, used to key Info.Confidence
+type Field string
+
+const (
+	TitleField         Field = "title"
+	YearField          Field = "year"
+	SeasonField        Field = "season"
+	EpisodeField       Field = "episode"
+	EpisodeTitleField  Field = "episodeTitle"
+	AirDateField       Field = "airDate"
+	AbsoluteField      Field = "absolute"
+	VersionField       Field = "version"
+	CRC32Field         Field = "crc32"
+	EpisodePartField   Field = "episodePart"
+	ResolutionField    Field = "resolution"
+	SourceField        Field = "source"
+	VideoCodecField    Field = "videoCodec"
+	AudioCodecField    Field = "audioCodec"
+	AudioChannelsField Field = "audioChannels"
+	GroupField         Field = "group"
+	EditionField       Field = "edition"
+	LanguageField      Field = "languages"
+	TypeField          Field = "type"
+
+	otherField Field = "" // Known tags that are not kept, eg. PROPER
+)
+
+type MediaType string
+
+const (
+	Movie   MediaType = "movie"
+	TV      MediaType = "tv"
+	Unknown MediaType = "unknown"
+)
+
+// Info is the metadata parsed from a release name, eg. Show.Name.S02E05.1080p.WEB-DL.x264-GROUP.
+// Confidence holds a score from 0 to 1 for each field that was found.
+type Info struct {
+	Title         string            `json:"title"`
+	Year          int               `json:"year,omitempty"`
+	Season        *int              `json:"season,omitempty"`      // Nil when there's none, 0 for specials, eg. S00E05
+	Episodes      []int             `json:"episodes,omitempty"`    // In file order, more than one for multi episode files, eg. S01E01E02
+	EpisodePart   int               `json:"episodePart,omitempty"` // Of a split episode, eg. S01E10.Part.2
+	EpisodeTitle  string            `json:"episodeTitle,omitempty"`
+	AirDate       string            `json:"airDate,omitempty"`  // YYYY-MM-DD, for daily shows named by date
+	Absolute      int               `json:"absolute,omitempty"` // Counted across the whole series, as anime fansubs do, eg. Show - 137
+	Version       int               `json:"version,omitempty"`  // Of a re-released episode, eg. 05v2
+	CRC32         string            `json:"crc32,omitempty"`    // Checksum of the file, eg. [ABCD1234]
+	Resolution    string            `json:"resolution,omitempty"`
+	Source        string            `json:"source,omitempty"`
+	VideoCodec    string            `json:"videoCodec,omitempty"`
+	AudioCodec    string            `json:"audioCodec,omitempty"`
+	AudioChannels string            `json:"audioChannels,omitempty"`
+	Group         string            `json:"group,omitempty"`
+	Edition       string            `json:"edition,omitempty"`
+	Languages     []string          `json:"languages,omitempty"` // ISO 639-1 codes, or multi, dual or nordic
+	Type          MediaType         `json:"type"`
+	Confidence    map[Field]float64 `json:"confidence"`
+}
+
+// match is a token found in the name, start and end are its span
+type match struct {
+	field      Field
+	start, end int
+	value      string
+	confidence float64
+}
+
+type parser struct {
+	name    string
+	start   int // Where the title starts, after any fansub group
+	info    Info
+	matches []match
+}
+
+// Parse reads what it can from a release or file name, with or without its extension.
+// Anything it can't place is left out, so only the title is always set.
+func Parse(name string) Info {
+	name = extRegex.ReplaceAllString(strings.TrimSpace(name), "")
+
+	p := &parser{name: name, info: Info{Type: Unknown, Confidence: map[Field]float64{}}}
+	p.fansub()
+	p.episode()
+	p.airDate()
+	p.absolute()
+	p.tokens(tokens)
+	p.year()
+	p.standalonePart()
+
+	titleEnd := p.firstTag()
+	p.title(titleEnd)
+	p.languages(titleEnd)
+	p.group(titleEnd)
+	p.episodeTitle()
+	p.mediaType()
+
+	return p.info
+}
+
+// claimed reports whether the span overlaps a match already made
+func (p *parser) claimed(start, end int) bool {
+	for _, m := range p.matches {
+		if start < m.end && m.start < end {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *parser) set(m match) {
+	p.matches = append(p.matches, m)
+
+	switch m.field {
+	case otherField:
+		return
+	case ResolutionField:
+		p.info.Resolution = m.value
+	case SourceField:
+		p.info.Source = m.value
+	case VideoCodecField:
+		p.info.VideoCodec = m.value
+	case AudioCodecField:
+		p.info.AudioCodec = m.value
+	case AudioChannelsField:
+		p.info.AudioChannels = m.value
+	case EditionField:
+		if strings.Contains(p.info.Edition, m.value) {
+			return
+		}
+
+		p.info.Edition = strings.TrimSpace(p.info.Edition + " " + m.value)
+	case LanguageField:
+		if slices.Contains(p.info.Languages, m.value) {
+			return
+		}
+
+		p.info.Languages = append(p.info.Languages, m.value)
+	}
+
+	// Keep the surest score when a field is seen more than once
+	p.info.Confidence[m.field] = max(p.info.Confidence[m.field], m.confidence)
+}
+
+// findAll is regexp.FindAllStringSubmatchIndex, allowing neighbouring matches to share the separator between them
+func findAll(re *regexp.Regexp, s string) [][]int {
+	var all [][]int
+	for off := 0; off < len(s); {
+		loc := re.FindStringSubmatchIndex(s[off:])
+		if loc == nil {
+			break
+		}
+
+		for i := range loc {
+			if loc[i] >= 0 {
+				loc[i] += off
+			}
+		}
+
+		all = append(all, loc)
+		off = loc[3]
+	}
+
+	return all
+}
+
+func (p *parser) episode() {
+	for _, marker := range episodeMarkers {
+		loc := marker.regex.FindStringSubmatchIndex(p.name)
+		if loc == nil {
+			continue
+		}
+
+		m := match{field: SeasonField, start: loc[2], end: loc[3], confidence: marker.confidence}
+		p.matches = append(p.matches, m)
+
+		if marker.seasonGroup > 0 {
+			season, _ := strconv.Atoi(p.submatch(loc, marker.seasonGroup))
+			p.info.Season = &season
+			p.info.Confidence[SeasonField] = marker.confidence
+		}
+
+		if marker.episodeGroup > 0 {
+			episode, _ := strconv.Atoi(p.submatch(loc, marker.episodeGroup))
+			p.info.Episodes = []int{episode}
+			p.info.Confidence[EpisodeField] = marker.confidence
+		}
+
+		if marker.moreGroup > 0 && loc[marker.moreGroup*2] >= 0 {
+			p.moreEpisodes(p.submatch(loc, marker.moreGroup))
+		}
+
+		if len(p.info.Episodes) > 0 {
+			p.version(p.submatch(loc, 1))
+			p.part(loc[3])
+		}
+
+		return
+	}
+}
+
+// fansub takes the leading [Group] and trailing [CRC32] of fansub releases
+func (p *parser) fansub() {
+	if loc := fansubGroupRegex.FindStringSubmatchIndex(p.name); loc != nil {
+		p.matches = append(p.matches, match{GroupField, loc[2], loc[3], p.submatch(loc, 1), 0.9})
+		p.info.Group = p.submatch(loc, 1)
+		p.info.Confidence[GroupField] = 0.9
+		p.start = loc[1]
+	}
+
+	all := crc32Regex.FindAllStringSubmatchIndex(p.name, -1)
+	if len(all) == 0 {
+		return
+	}
+
+	loc := all[len(all)-1]
+	p.matches = append(p.matches, match{CRC32Field, loc[2], loc[3], p.submatch(loc, 1), 0.95})
+	p.info.CRC32 = strings.ToUpper(p.submatch(loc, 1))
+	p.info.Confidence[CRC32Field] = 0.95
+}
+
+// absolute finds an episode number after a dash, as fansubs name them, eg. Show - 137.
+// It's absolute unless a season came before it, eg. Show S2 - 05.
+func (p *parser) absolute() {
+	if len(p.info.Episodes) > 0 || p.info.AirDate != "" {
+		return
+	}
+
+	for _, loc := range absoluteRegex.FindAllStringSubmatchIndex(p.name, -1) {
+		if loc[2] < p.start || p.claimed(loc[2], loc[3]) || yearRegex.MatchString(p.submatch(loc, 2)) {
+			continue
+		}
+
+		episode, _ := strconv.Atoi(p.submatch(loc, 2))
+		p.matches = append(p.matches, match{AbsoluteField, loc[2], loc[3], p.submatch(loc, 1), 0.8})
+
+		if p.info.Season != nil {
+			p.info.Episodes = []int{episode}
+			p.info.Confidence[EpisodeField] = 0.8
+		} else {
+			p.info.Absolute = episode
+			p.info.Confidence[AbsoluteField] = 0.8
+		}
+
+		p.version(p.submatch(loc, 1))
+		return
+	}
+}
+
+// version takes the version suffix of an episode marker, eg. 05v2
+func (p *parser) version(marker string) {
+	groups := versionRegex.FindStringSubmatch(marker)
+	if groups == nil {
+		return
+	}
+
+	p.info.Version, _ = strconv.Atoi(groups[1])
+	p.info.Confidence[VersionField] = 0.9
+}
+
+// airDate finds the date a daily show aired, when there's no episode number, eg. Show.2024.03.15.Guest.Name
+func (p *parser) airDate() {
+	if len(p.info.Episodes) > 0 {
+		return
+	}
+
+	if loc := airDateRegex.FindStringSubmatchIndex(p.name); loc != nil {
+		p.setAirDate(loc, p.submatch(loc, 2), p.submatch(loc, 3), p.submatch(loc, 4), 0.9)
+		return
+	}
+
+	loc := airDateLastRegex.FindStringSubmatchIndex(p.name)
+	if loc == nil {
+		return
+	}
+
+	// Day first unless it can't be, eg. 03.15.2024, which is also less certain
+	day, month := p.submatch(loc, 2), p.submatch(loc, 3)
+	confidence := 0.8
+	if month > "12" {
+		day, month = month, day
+		confidence = 0.7
+	}
+
+	p.setAirDate(loc, p.submatch(loc, 4), month, day, confidence)
+}
+
+func (p *parser) setAirDate(loc []int, year, month, day string, confidence float64) {
+	date, err := time.Parse(time.DateOnly, year+"-"+month+"-"+day)
+	if err != nil {
+		return
+	}
+
+	p.matches = append(p.matches, match{AirDateField, loc[2], loc[3], date.Format(time.DateOnly), confidence})
+	p.info.AirDate = date.Format(time.DateOnly)
+	p.info.Confidence[AirDateField] = confidence
+}
+
+// submatch returns the text of capture group i of a match
+func (p *parser) submatch(loc []int, i int) string {
+	return p.name[loc[i*2]:loc[i*2+1]]
+}
+
+// moreEpisodes adds the rest of a multi episode marker, eg. E02E03 or -E05, expanding ranges
+func (p *parser) moreEpisodes(more string) {
+	for _, groups := range moreEpisodesRegex.FindAllStringSubmatch(more, -1) {
+		episode, _ := strconv.Atoi(groups[2])
+		last := p.info.Episodes[len(p.info.Episodes)-1]
+
+		if groups[1] != "" && episode > last && episode-last <= MaxEpisodeRange {
+			for n := last + 1; n <= episode; n++ {
+				p.info.Episodes = append(p.info.Episodes, n)
+			}
+
+			continue
+		}
+
+		if !slices.Contains(p.info.Episodes, episode) {
+			p.info.Episodes = append(p.info.Episodes, episode)
+		}
+	}
+}
+
+// part finds which part of a split episode the file holds, after the episode marker ending at from
+func (p *parser) part(from int) {
+	loc := partRegex.FindStringSubmatchIndex(p.name[from:])
+	if loc == nil {
+		return
+	}
+
+	for i := range loc {
+		loc[i] += from
+	}
+
+	p.setPart(loc, 0.7)
+}
+
+// standalonePart finds a part without an episode marker, eg. Show.Name.Part.1.720p, when it's the last thing
+// before the tags. One followed by a year is left in the title, eg. Deathly.Hallows.Part.1.2010.
+func (p *parser) standalonePart() {
+	if p.info.EpisodePart > 0 {
+		return
+	}
+
+	first := p.firstTag()
+	for _, m := range p.matches {
+		if m.start == first && m.field == YearField {
+			return
+		}
+	}
+
+	for _, loc := range findAll(partRegex, p.name) {
+		if loc[2] <= p.start || loc[3] > first || p.claimed(loc[2], loc[3]) {
+			continue
+		}
+
+		if strings.Trim(p.name[loc[3]:first], " ._-[]()") == "" {
+			p.setPart(loc, 0.6)
+			return
+		}
+	}
+}
+
+func (p *parser) setPart(loc []int, confidence float64) {
+	p.matches = append(p.matches, match{EpisodePartField, loc[2], loc[3], p.submatch(loc, 2), confidence})
+	p.info.EpisodePart, _ = strconv.Atoi(p.submatch(loc, 2))
+	p.info.Confidence[EpisodePartField] = confidence
+}
+
+func (p *parser) tokens(tokens []token) {
+	for _, t := range tokens {
+		for _, loc := range findAll(t.regex, p.name) {
+			// A tag at the very start is more likely part of the title, eg. French Kiss
+			if loc[2] <= p.start || p.claimed(loc[2], loc[3]) {
+				continue
+			}
+
+			p.set(match{t.field, loc[2], loc[3], t.value, t.confidence})
+
+			// Channels following an audio codec, eg. DDP5.1
+			for i := 4; i < len(loc); i += 2 {
+				if loc[i] >= 0 {
+					p.set(match{AudioChannelsField, loc[i], loc[i+1], p.name[loc[i]:loc[i+1]], t.confidence})
+					break
+				}
+			}
+		}
+	}
+}
+
+// firstTag returns where the first tag other than a language starts, or the end of the name
+func (p *parser) firstTag() int {
+	first := len(p.name)
+	for _, m := range p.matches {
+		if m.field != LanguageField && m.field != GroupField {
+			first = min(first, m.start)
+		}
+	}
+
+	return first
+}
+
+// year picks the last year before the tags, so titles can hold years too, eg. Blade Runner 2049 2017
+func (p *parser) year() {
+	first := p.firstTag()
+
+	var year *match
+	for _, loc := range findAll(yearRegex, p.name) {
+		if loc[2] <= p.start || p.claimed(loc[2], loc[3]) {
+			continue
+		}
+
+		confidence := 0.8
+		if strings.ContainsAny(p.name[loc[0]:loc[2]], "([") {
+			confidence = 0.9
+		}
+
+		m := match{YearField, loc[2], loc[3], p.name[loc[2]:loc[3]], confidence}
+		if loc[2] > first {
+			if year == nil {
+				year = &m
+			}
+
+			break
+		}
+
+		year = &m
+	}
+
+	if year == nil {
+		return
+	}
+
+	p.matches = append(p.matches, *year)
+	p.info.Year, _ = strconv.Atoi(year.value)
+	p.info.Confidence[YearField] = year.confidence
+}
+
+func (p *parser) title(end int) {
+	p.info.Title = clean(p.name[p.start:max(end, p.start)])
+	if p.info.Title == "" {
+		return
+	}
+
+	// A title ended by an episode or year is more likely whole than one ended by a stray tag
+	confidence := 0.4
+	for _, m := range p.matches {
+		if m.start != end {
+			continue
+		}
+
+		switch m.field {
+		case SeasonField, YearField, AirDateField, AbsoluteField:
+			confidence = 0.9
+		default:
+			confidence = 0.7
+		}
+	}
+
+	p.info.Confidence[TitleField] = confidence
+}
+
+func (p *parser) languages(titleEnd int) {
+	for _, t := range languageTokens {
+		for _, loc := range findAll(t.regex, p.name) {
+			if loc[2] < titleEnd || p.claimed(loc[2], loc[3]) {
+				continue
+			}
+
+			p.set(match{t.field, loc[2], loc[3], t.value, t.confidence})
+		}
+	}
+}
+
+// group finds the release group, eg. -GROUP, which always follows the tags, unless a fansub group led the name
+func (p *parser) group(titleEnd int) {
+	if p.info.Group != "" {
+		return
+	}
+
+	loc := groupRegex.FindStringSubmatchIndex(p.name)
+	if loc == nil || loc[2] <= titleEnd || p.claimed(loc[2], loc[3]) {
+		return
+	}
+
+	p.matches = append(p.matches, match{GroupField, loc[2], loc[3], p.name[loc[2]:loc[3]], 0.85})
+	p.info.Group = p.name[loc[2]:loc[3]]
+	p.info.Confidence[GroupField] = 0.85
+}
+
+// episodeTitle takes the words between the episode or air date and the next tag, eg. Show.S01E01.Pilot.720p
+func (p *parser) episodeTitle() {
+	var marker *match
+	for i, m := range p.matches {
+		if (m.field == SeasonField && len(p.info.Episodes) > 0) || m.field == AirDateField || m.field == AbsoluteField {
+			marker = &p.matches[i]
+		}
+	}
+
+	if marker == nil {
+		return
+	}
+
+	end := len(p.name)
+	for _, m := range p.matches {
+		if m.start >= marker.end {
+			end = min(end, m.start)
+		}
+	}
+
+	if title := clean(p.name[marker.end:end]); title != "" {
+		p.info.EpisodeTitle = title
+		p.info.Confidence[EpisodeTitleField] = 0.6
+	}
+}
+
+func (p *parser) mediaType() {
+	switch {
+	case len(p.info.Episodes) > 0, p.info.Absolute > 0:
+		p.info.Type = TV
+		p.info.Confidence[TypeField] = 0.9
+	case p.info.Season != nil, p.info.AirDate != "":
+		p.info.Type = TV
+		p.info.Confidence[TypeField] = 0.8
+	case p.info.Year > 0:
+		p.info.Type = Movie
+		p.info.Confidence[TypeField] = 0.7
+	}
+}
+
+// clean turns separators back into spaces, keeping the dots of acronyms, eg. S.H.I.E.L.D.
+func clean(s string) string {
+	dotted := !strings.Contains(s, " ")
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ' ' || r == '_' || (dotted && r == '.')
+	})
+
+	var b strings.Builder
+	for i, word := range words {
+		if i > 0 {
+			if len(word) == 1 && len(words[i-1]) == 1 && dotted {
+				b.WriteByte('.')
+			} else {
+				b.WriteByte(' ')
+			}
+		}
+
+		b.WriteString(word)
+
+		// Close the acronym, so S.H.I.E.L.D keeps its last dot
+		last := i == len(words)-1 || len(words[i+1]) > 1
+		if dotted && last && len(word) == 1 && i > 0 && len(words[i-1]) == 1 {
+			b.WriteByte('.')
+		}
+	}
+
+	return strings.Trim(b.String(), " -[](){}")
+}
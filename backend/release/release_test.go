@@ -0,0 +1,318 @@
+package release
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		want Info
+	}{
+		// Scene and P2P movies
+		{"The.Matrix.1999.1080p.BluRay.x264-SPARKS", Info{
+			Title: "The Matrix", Year: 1999, Resolution: "1080p", Source: "BluRay", VideoCodec: "H.264",
+			Group: "SPARKS", Type: Movie,
+		}},
+		{"Movie.Title.2019.MULTi.FRENCH.1080p.BluRay.x264-GRP", Info{
+			Title: "Movie Title", Year: 2019, Resolution: "1080p", Source: "BluRay", VideoCodec: "H.264",
+			Group: "GRP", Languages: []string{"multi", "fr"}, Type: Movie,
+		}},
+		{"Movie.2019.HDTS.x264-GRP", Info{
+			Title: "Movie", Year: 2019, Source: "Telesync", VideoCodec: "H.264", Group: "GRP", Type: Movie,
+		}},
+		{"Movie.Title.2019.Directors.Cut.1080p.BluRay.DTS-HD.MA.5.1.x264-GRP", Info{
+			Title: "Movie Title", Year: 2019, Resolution: "1080p", Source: "BluRay", VideoCodec: "H.264",
+			AudioCodec: "DTS-HD MA", AudioChannels: "5.1", Group: "GRP", Edition: "Director's Cut", Type: Movie,
+		}},
+		{"Movie.Title.2019.EXTENDED.REMASTERED.2160p.UHD.BluRay.TrueHD.7.1.Atmos.x265-GRP", Info{
+			Title: "Movie Title", Year: 2019, Resolution: "2160p", Source: "BluRay", VideoCodec: "H.265",
+			AudioCodec: "TrueHD", AudioChannels: "7.1", Group: "GRP", Edition: "Extended Remastered", Type: Movie,
+		}},
+		{"Movie.Title.2019.1080p.AMZN.WEB-DL.DDP5.1.Atmos.H.264-GRP", Info{
+			Title: "Movie Title", Year: 2019, Resolution: "1080p", Source: "WEB-DL", VideoCodec: "H.264",
+			AudioCodec: "E-AC-3", AudioChannels: "5.1", Group: "GRP", Type: Movie,
+		}},
+		{"Movie.Title.2019.IMAX.1080p.WEB-DL.DDP5.1.H.264-GRP", Info{
+			Title: "Movie Title", Year: 2019, Resolution: "1080p", Source: "WEB-DL", VideoCodec: "H.264",
+			AudioCodec: "E-AC-3", AudioChannels: "5.1", Group: "GRP", Edition: "IMAX", Type: Movie,
+		}},
+		{"Movie.Title.2019.Criterion.Collection.1080p.BluRay.FLAC.2.0.x264-GRP", Info{
+			Title: "Movie Title", Year: 2019, Resolution: "1080p", Source: "BluRay", VideoCodec: "H.264",
+			AudioCodec: "FLAC", AudioChannels: "2.0", Group: "GRP", Edition: "Criterion", Type: Movie,
+		}},
+		{"Movie.Title.2019.UNRATED.DVDRip.XviD.AC3-GRP", Info{
+			Title: "Movie Title", Year: 2019, Source: "DVD", VideoCodec: "XviD", AudioCodec: "AC-3", Group: "GRP",
+			Edition: "Unrated", Type: Movie,
+		}},
+		{"Movie.Title.2019.1080p.BluRay.AAC5.1.x264-GRP", Info{
+			Title: "Movie Title", Year: 2019, Resolution: "1080p", Source: "BluRay", VideoCodec: "H.264",
+			AudioCodec: "AAC", AudioChannels: "5.1", Group: "GRP", Type: Movie,
+		}},
+		{"Movie Title (2019) [1080p] [BluRay] [5.1] [YTS.MX]", Info{
+			Title: "Movie Title", Year: 2019, Resolution: "1080p", Source: "BluRay", AudioChannels: "5.1", Type: Movie,
+		}},
+		{"Movie.Title.2019.1080p.WEBRip.x265.10bit-GRP[rarbg]", Info{
+			Title: "Movie Title", Year: 2019, Resolution: "1080p", Source: "WEBRip", VideoCodec: "H.265",
+			Group: "GRP", Type: Movie,
+		}},
+		{"Movie.Title.2019.720p.HDTV.x264-GRP.mkv", Info{
+			Title: "Movie Title", Year: 2019, Resolution: "720p", Source: "HDTV", VideoCodec: "H.264",
+			Group: "GRP", Type: Movie,
+		}},
+
+		// Languages, and titles that look like them
+		{"Movie.Title.2019.German.DL.1080p.BluRay.x264-GRP", Info{
+			Title: "Movie Title", Year: 2019, Resolution: "1080p", Source: "BluRay", VideoCodec: "H.264",
+			Group: "GRP", Languages: []string{"de"}, Type: Movie,
+		}},
+		{"Movie.Title.2019.DUAL.1080p.BluRay.x264-GRP", Info{
+			Title: "Movie Title", Year: 2019, Resolution: "1080p", Source: "BluRay", VideoCodec: "H.264",
+			Group: "GRP", Languages: []string{"dual"}, Type: Movie,
+		}},
+		{"Movie.Title.2019.NORDiC.1080p.WEB-DL.H.264-GRP", Info{
+			Title: "Movie Title", Year: 2019, Resolution: "1080p", Source: "WEB-DL", VideoCodec: "H.264",
+			Group: "GRP", Languages: []string{"nordic"}, Type: Movie,
+		}},
+		{"The.Italian.Job.2003.1080p.BluRay.x264-GRP", Info{
+			Title: "The Italian Job", Year: 2003, Resolution: "1080p", Source: "BluRay", VideoCodec: "H.264",
+			Group: "GRP", Type: Movie,
+		}},
+		{"French.Kiss.1995.1080p.BluRay.x264-GRP", Info{
+			Title: "French Kiss", Year: 1995, Resolution: "1080p", Source: "BluRay", VideoCodec: "H.264",
+			Group: "GRP", Type: Movie,
+		}},
+
+		// Years in the title
+		{"Blade.Runner.2049.2017.2160p.UHD.BluRay.REMUX.HDR.HEVC.Atmos-EPSiLON", Info{
+			Title: "Blade Runner 2049", Year: 2017, Resolution: "2160p", Source: "BluRay", VideoCodec: "H.265",
+			Group: "EPSiLON", Type: Movie,
+		}},
+		{"1917.2019.1080p.WEB-DL.DD5.1.H264-FGT", Info{
+			Title: "1917", Year: 2019, Resolution: "1080p", Source: "WEB-DL", VideoCodec: "H.264",
+			AudioCodec: "AC-3", AudioChannels: "5.1", Group: "FGT", Type: Movie,
+		}},
+		{"2012.2009.720p.BluRay.x264-GRP", Info{
+			Title: "2012", Year: 2009, Resolution: "720p", Source: "BluRay", VideoCodec: "H.264",
+			Group: "GRP", Type: Movie,
+		}},
+
+		// Episodes
+		{"Marvels.Agents.of.S.H.I.E.L.D.S01E01.Pilot.720p.WEB-DL.DD5.1.H.264-GRP", Info{
+			Title: "Marvels Agents of S.H.I.E.L.D.", Season: seasonOf(1), Episodes: []int{1}, EpisodeTitle: "Pilot",
+			Resolution: "720p", Source: "WEB-DL", VideoCodec: "H.264", AudioCodec: "AC-3", AudioChannels: "5.1",
+			Group: "GRP", Type: TV,
+		}},
+		{"Game.of.Thrones.S08E03.The.Long.Night.1080p.AMZN.WEB-DL.DDP5.1.H.264-GoT", Info{
+			Title: "Game of Thrones", Season: seasonOf(8), Episodes: []int{3}, EpisodeTitle: "The Long Night",
+			Resolution: "1080p", Source: "WEB-DL", VideoCodec: "H.264", AudioCodec: "E-AC-3", AudioChannels: "5.1",
+			Group: "GoT", Type: TV,
+		}},
+		{"Show.Name.S01E01E02.720p.HDTV.x264-GRP", Info{
+			Title: "Show Name", Season: seasonOf(1), Episodes: []int{1, 2}, Resolution: "720p", Source: "HDTV",
+			VideoCodec: "H.264", Group: "GRP", Type: TV,
+		}},
+		{"Show.Name.S02E01-E03.1080p.WEB.h264-GRP", Info{
+			Title: "Show Name", Season: seasonOf(2), Episodes: []int{1, 2, 3}, Resolution: "1080p", Source: "WEB-DL",
+			VideoCodec: "H.264", Group: "GRP", Type: TV,
+		}},
+		{"Show.Name.S01.E01.E02.720p", Info{
+			Title: "Show Name", Season: seasonOf(1), Episodes: []int{1, 2}, Resolution: "720p", Type: TV,
+		}},
+		{"Show.Name.S01E01-02.720p.HDTV", Info{
+			Title: "Show Name", Season: seasonOf(1), Episodes: []int{1, 2}, Resolution: "720p", Source: "HDTV", Type: TV,
+		}},
+		{"Show.Name.S01E01-E99.720p", Info{
+			// Too long to be a range, see MaxEpisodeRange
+			Title: "Show Name", Season: seasonOf(1), Episodes: []int{1, 99}, Resolution: "720p", Type: TV,
+		}},
+		{"Show.1x01-1x02.720p.HDTV.x264-GRP", Info{
+			Title: "Show", Season: seasonOf(1), Episodes: []int{1, 2}, Resolution: "720p", Source: "HDTV",
+			VideoCodec: "H.264", Group: "GRP", Type: TV,
+		}},
+		{"Show.Name.2x01-03.720p", Info{
+			Title: "Show Name", Season: seasonOf(2), Episodes: []int{1, 2, 3}, Resolution: "720p", Type: TV,
+		}},
+		{"Show.Name.1x01x02.DVDRip", Info{
+			Title: "Show Name", Season: seasonOf(1), Episodes: []int{1, 2}, Source: "DVD", Type: TV,
+		}},
+		{"Show.Name.1x05.HDTV.XviD-GRP", Info{
+			Title: "Show Name", Season: seasonOf(1), Episodes: []int{5}, Source: "HDTV", VideoCodec: "XviD",
+			Group: "GRP", Type: TV,
+		}},
+		{"Show.Name.Season.2.Episode.5.720p", Info{
+			Title: "Show Name", Season: seasonOf(2), Episodes: []int{5}, Resolution: "720p", Type: TV,
+		}},
+		{"Show.Name.Season.1.1080p.BluRay.x264-GRP", Info{
+			Title: "Show Name", Season: seasonOf(1), Resolution: "1080p", Source: "BluRay", VideoCodec: "H.264",
+			Group: "GRP", Type: TV,
+		}},
+		{"Show.Name.Ep.12.HDTV", Info{
+			Title: "Show Name", Episodes: []int{12}, Source: "HDTV", Type: TV,
+		}},
+		{"Show.Name.S01E05v2.720p.WEB-DL.AAC2.0.H.264-GRP", Info{
+			Title: "Show Name", Season: seasonOf(1), Episodes: []int{5}, Version: 2, Resolution: "720p",
+			Source: "WEB-DL", VideoCodec: "H.264", AudioCodec: "AAC", AudioChannels: "2.0", Group: "GRP", Type: TV,
+		}},
+		{"Show.Name.S03E10.Finale.Pt.2.1080p.WEB.h264-GRP", Info{
+			Title: "Show Name", Season: seasonOf(3), Episodes: []int{10}, EpisodePart: 2, EpisodeTitle: "Finale",
+			Resolution: "1080p", Source: "WEB-DL", VideoCodec: "H.264", Group: "GRP", Type: TV,
+		}},
+
+		// Specials
+		{"Show.S00E05.Part.1.720p.HDTV.x264-GRP", Info{
+			Title: "Show", Season: seasonOf(0), Episodes: []int{5}, EpisodePart: 1, Resolution: "720p", Source: "HDTV",
+			VideoCodec: "H.264", Group: "GRP", Type: TV,
+		}},
+		{"Show.S00.Specials.720p.WEB.h264-GRP", Info{
+			Title: "Show", Season: seasonOf(0), Resolution: "720p", Source: "WEB-DL", VideoCodec: "H.264",
+			Group: "GRP", Type: TV,
+		}},
+
+		// Split episodes, with or without an episode
+		{"Show.S01E05.Part.1.720p.HDTV.x264-GRP", Info{
+			Title: "Show", Season: seasonOf(1), Episodes: []int{5}, EpisodePart: 1, Resolution: "720p", Source: "HDTV",
+			VideoCodec: "H.264", Group: "GRP", Type: TV,
+		}},
+		{"Show.Name.Part.1.720p.HDTV.x264-GRP", Info{
+			Title: "Show Name", EpisodePart: 1, Resolution: "720p", Source: "HDTV", VideoCodec: "H.264",
+			Group: "GRP", Type: Unknown,
+		}},
+		{"Show.Name.Pt.2", Info{
+			Title: "Show Name", EpisodePart: 2, Type: Unknown,
+		}},
+		{"Harry.Potter.and.the.Deathly.Hallows.Part.1.2010.1080p.BluRay.x264-GRP", Info{
+			Title: "Harry Potter and the Deathly Hallows Part 1", Year: 2010, Resolution: "1080p", Source: "BluRay",
+			VideoCodec: "H.264", Group: "GRP", Type: Movie,
+		}},
+
+		// Air dates
+		{"The.Daily.Show.2023.03.15.Guest.Name.720p.WEB.h264-GRP", Info{
+			Title: "The Daily Show", AirDate: "2023-03-15", EpisodeTitle: "Guest Name", Resolution: "720p",
+			Source: "WEB-DL", VideoCodec: "H.264", Group: "GRP", Type: TV,
+		}},
+		{"The.Tonight.Show.15.03.2024.720p.WEB.h264-GRP", Info{
+			Title: "The Tonight Show", AirDate: "2024-03-15", Resolution: "720p", Source: "WEB-DL", VideoCodec: "H.264",
+			Group: "GRP", Type: TV,
+		}},
+		{"The.Tonight.Show.03.15.2024.720p.WEB.h264-GRP", Info{
+			Title: "The Tonight Show", AirDate: "2024-03-15", Resolution: "720p", Source: "WEB-DL", VideoCodec: "H.264",
+			Group: "GRP", Type: TV,
+		}},
+		{"The.Tonight.Show.2024-03-15.720p", Info{
+			Title: "The Tonight Show", AirDate: "2024-03-15", Resolution: "720p", Type: TV,
+		}},
+
+		// Fansubs
+		{"[SubsPlease] Jujutsu Kaisen - 24 (1080p) [ABCD1234].mkv", Info{
+			Title: "Jujutsu Kaisen", Absolute: 24, CRC32: "ABCD1234", Resolution: "1080p", Group: "SubsPlease",
+			Type: TV,
+		}},
+		{"[Erai-raws] One Piece - 1071v2 [1080p][Multiple Subtitle].mkv", Info{
+			Title: "One Piece", Absolute: 1071, Version: 2, Resolution: "1080p", Group: "Erai-raws", Type: TV,
+		}},
+		{"[HorribleSubs] Show Name - 05 [720p].mkv", Info{
+			Title: "Show Name", Absolute: 5, Resolution: "720p", Group: "HorribleSubs", Type: TV,
+		}},
+		{"[Group] Show Name - 1100 [1080p].mkv", Info{
+			Title: "Show Name", Absolute: 1100, Resolution: "1080p", Group: "Group", Type: TV,
+		}},
+		{"Show_Name_-_07_[720p].mkv", Info{
+			Title: "Show Name", Absolute: 7, Resolution: "720p", Type: TV,
+		}},
+		{"[Group] Show Name S2 - 05 [1080p].mkv", Info{
+			Title: "Show Name", Season: seasonOf(2), Episodes: []int{5}, Resolution: "1080p", Group: "Group", Type: TV,
+		}},
+		{"[Group] Show Name S2 - 05v3 [1080p][ABCD1234].mkv", Info{
+			Title: "Show Name", Season: seasonOf(2), Episodes: []int{5}, Version: 3, CRC32: "ABCD1234",
+			Resolution: "1080p", Group: "Group", Type: TV,
+		}},
+		{"[Group] Show Name S0 - 01 [1080p].mkv", Info{
+			Title: "Show Name", Season: seasonOf(0), Episodes: []int{1}, Resolution: "1080p", Group: "Group", Type: TV,
+		}},
+
+		// Words in episode titles that look like tags
+		{"Show.S01E03.Dan.Returns.720p.HDTV-GRP", Info{
+			Title: "Show", Season: seasonOf(1), Episodes: []int{3}, EpisodeTitle: "Dan Returns", Resolution: "720p",
+			Source: "HDTV", Group: "GRP", Type: TV,
+		}},
+		{"Show.S02E07.Spa.Day", Info{
+			Title: "Show", Season: seasonOf(2), Episodes: []int{7}, EpisodeTitle: "Spa Day", Type: TV,
+		}},
+		{"Show.S03E05.Nor.Any.Drop", Info{
+			Title: "Show", Season: seasonOf(3), Episodes: []int{5}, EpisodeTitle: "Nor Any Drop", Type: TV,
+		}},
+		{"Show.S01E04.Ts.Eliot", Info{
+			Title: "Show", Season: seasonOf(1), Episodes: []int{4}, EpisodeTitle: "Ts Eliot", Type: TV,
+		}},
+		{"Show.S01E01.The.Web.720p", Info{
+			Title: "Show", Season: seasonOf(1), Episodes: []int{1}, EpisodeTitle: "The Web", Resolution: "720p", Type: TV,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Parse(tt.name)
+			confidence := got.Confidence
+			got.Confidence = nil
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse(%q)\n got %+v\nwant %+v", tt.name, got, tt.want)
+			}
+
+			for field, set := range setFields(got) {
+				if _, ok := confidence[field]; ok != set {
+					t.Errorf("Parse(%q) confidence for %s = %v, field set = %v", tt.name, field, ok, set)
+				}
+			}
+		})
+	}
+}
+
+// Day first dates are surer than month first ones, which are only taken when the day can't be the month
+func TestParseAirDateConfidence(t *testing.T) {
+	tests := []struct {
+		name       string
+		confidence float64
+	}{
+		{"Show.2024.03.15", 0.9},
+		{"Show.15.03.2024", 0.8},
+		{"Show.03.15.2024", 0.7},
+	}
+
+	for _, tt := range tests {
+		info := Parse(tt.name)
+		if info.AirDate != "2024-03-15" || info.Confidence[AirDateField] != tt.confidence {
+			t.Errorf("Parse(%q) = %s with confidence %v, want 2024-03-15 with %v",
+				tt.name, info.AirDate, info.Confidence[AirDateField], tt.confidence)
+		}
+	}
+}
+
+func seasonOf(n int) *int {
+	return &n
+}
+
+func setFields(info Info) map[Field]bool {
+	return map[Field]bool{
+		TitleField:         info.Title != "",
+		YearField:          info.Year != 0,
+		SeasonField:        info.Season != nil,
+		EpisodeField:       len(info.Episodes) > 0,
+		EpisodeTitleField:  info.EpisodeTitle != "",
+		AirDateField:       info.AirDate != "",
+		AbsoluteField:      info.Absolute != 0,
+		VersionField:       info.Version != 0,
+		CRC32Field:         info.CRC32 != "",
+		EpisodePartField:   info.EpisodePart != 0,
+		ResolutionField:    info.Resolution != "",
+		SourceField:        info.Source != "",
+		VideoCodecField:    info.VideoCodec != "",
+		AudioCodecField:    info.AudioCodec != "",
+		AudioChannelsField: info.AudioChannels != "",
+		GroupField:         info.Group != "",
+		EditionField:       info.Edition != "",
+		LanguageField:      len(info.Languages) > 0,
+	}
+}
@@ -0,0 +1,184 @@
+package release
+
+import "regexp"
+
+// token matches one tag of a release name, eg. 1080p or x264, and its canonical value
+type token struct {
+	field      Field
+	regex      *regexp.Regexp
+	value      string
+	confidence float64
+}
+
+// tok wraps body so it only matches between separators, as the first capture group
+func tok(body string) *regexp.Regexp {
+	return regexp.MustCompile(`(?i)(?:^|[\s._\-\[\(])(` + body + `)(?:$|[\s._\-\]\)])`)
+}
+
+// tokCase is tok matching by case
+func tokCase(body string) *regexp.Regexp {
+	return regexp.MustCompile(`(?:^|[\s._\-\[\(])(` + body + `)(?:$|[\s._\-\]\)])`)
+}
+
+// episodeMarker matches a season and/or episode number, seasonGroup and episodeGroup
+// are the capture groups holding them, 0 when the marker doesn't include one.
+// moreGroup holds any further episodes of a multi episode file, see moreEpisodesRegex.
+// Markers can end in a version, eg. S01E05v2, see versionRegex.
+type episodeMarker struct {
+	regex        *regexp.Regexp
+	seasonGroup  int
+	episodeGroup int
+	moreGroup    int
+	confidence   float64
+}
+
+// Markers are tried in order, the first to match wins
+var episodeMarkers = []episodeMarker{
+	{tok(`S(\d{1,2})[\s._-]?E(\d{1,3})((?:[\s._-]?E\d{1,3}|-E?\d{1,3})*)(?:v\d)?`), 2, 3, 4, 0.95},
+	{tok(`Season[\s._-]?(\d{1,2})[\s._-]+Episode[\s._-]?(\d{1,3})`), 2, 3, 0, 0.9},
+	{tok(`(\d{1,2})x(\d{2,3})((?:-\d{1,2}x\d{2,3}|-\d{2,3}|x\d{2,3})*)(?:v\d)?`), 2, 3, 4, 0.8},
+	{tok(`Season[\s._-]?(\d{1,2})`), 2, 0, 0, 0.85},
+	{tok(`S(\d{1,2})`), 2, 0, 0, 0.7},
+	{tok(`(?:Episode|Ep)[\s._-]?(\d{1,3})`), 0, 2, 0, 0.6},
+}
+
+var (
+	// Each further episode, a leading hyphen makes it the end of a range, eg. -E03 or -1x03
+	moreEpisodesRegex = regexp.MustCompile(`(?i)(-)?(?:\d{1,2}x|E)?(\d{1,3})`)
+	// Split episodes, eg. Show.S01E10.Finale.Part.2, only looked for after the episode
+	partRegex    = tok(`(?:Part|Pt)[\s._-]?(\d{1,2})`)
+	versionRegex = regexp.MustCompile(`(?i)v(\d)$`)
+)
+
+// Fansub conventions, eg. [Group] Show - 137v2 [1080p][ABCD1234]
+var (
+	fansubGroupRegex = regexp.MustCompile(`^\[([^\]]+)\][\s._-]*`)
+	crc32Regex       = regexp.MustCompile(`\[([0-9A-Fa-f]{8})\]`)
+	// An episode number after a dash, absolute unless a season was given, eg. Show S2 - 05
+	absoluteRegex = regexp.MustCompile(`(?i)(?:^|[\s_])-[\s_]+((\d{1,4})(?:v(\d))?)(?:$|[\s._\[\(])`)
+)
+
+// MaxEpisodeRange bounds how many episodes a range like S01E01-E99 can expand to
+const MaxEpisodeRange = 50
+
+var yearRegex = tok(`(?:19|20)\d{2}`)
+
+// Air dates of daily shows, year first, eg. 2024.03.15, or last, eg. 15.03.2024 or 03.15.2024
+var (
+	airDateRegex     = tok(`((?:19|20)\d{2})[\s._-](\d{2})[\s._-](\d{2})`)
+	airDateLastRegex = tok(`(\d{2})[\s._-](\d{2})[\s._-]((?:19|20)\d{2})`)
+)
+
+// Tokens are matched in order, a token overlapping an earlier match is skipped
+var tokens = []token{
+	{ResolutionField, tok(`2160p|4K|UHD`), "2160p", 0.95},
+	{ResolutionField, tok(`1440p`), "1440p", 0.95},
+	{ResolutionField, tok(`1080[pi]`), "1080p", 0.95},
+	{ResolutionField, tok(`720p`), "720p", 0.95},
+	{ResolutionField, tok(`576[pi]`), "576p", 0.9},
+	{ResolutionField, tok(`480[pi]`), "480p", 0.9},
+
+	{SourceField, tok(`Blu-?Ray|BDRip|BRRip|BD(?:25|50)?|BDRemux`), "BluRay", 0.9},
+	{SourceField, tok(`WEB-?Rip`), "WEBRip", 0.9},
+	{SourceField, tok(`WEB-?DL`), "WEB-DL", 0.9},
+	{SourceField, tokCase(`WEB`), "WEB-DL", 0.85},
+	{SourceField, tok(`HDTV|PDTV|SDTV|DSR|TVRip`), "HDTV", 0.85},
+	{SourceField, tok(`DVD-?Rip|DVD(?:5|9|R)?`), "DVD", 0.85},
+	{SourceField, tok(`HDRip`), "HDRip", 0.8},
+	{SourceField, tok(`(?:HD)?CAM-?Rip`), "CAM", 0.8},
+	{SourceField, tokCase(`(?:HD)?CAM`), "CAM", 0.8},
+	{SourceField, tok(`TELESYNC`), "Telesync", 0.8},
+	{SourceField, tokCase(`(?:HD)?TS`), "Telesync", 0.6},
+	{SourceField, tok(`DVDScr|SCR|SCREENER`), "Screener", 0.7},
+
+	{VideoCodecField, tok(`[xh]\.?264|AVC`), "H.264", 0.9},
+	{VideoCodecField, tok(`[xh]\.?265|HEVC`), "H.265", 0.9},
+	{VideoCodecField, tok(`AV1`), "AV1", 0.85},
+	{VideoCodecField, tok(`VP9`), "VP9", 0.85},
+	{VideoCodecField, tok(`XviD`), "XviD", 0.9},
+	{VideoCodecField, tok(`DivX`), "DivX", 0.9},
+	{VideoCodecField, tok(`MPEG-?2`), "MPEG-2", 0.8},
+
+	// The audio codecs can be followed directly by their channels, eg. DDP5.1, captured in a group of their own
+	{AudioCodecField, tok(`TrueHD(?:[\s._-]?Atmos)?([257]\.[01])?`), "TrueHD", 0.9},
+	{AudioCodecField, tok(`DTS-?HD[\s._-]?MA([257]\.[01])?`), "DTS-HD MA", 0.9},
+	{AudioCodecField, tok(`DTS(?:-?HD|-?X|-?ES)?([257]\.[01])?`), "DTS", 0.85},
+	{AudioCodecField, tok(`(?:DDP|DD\+|E-?AC-?3)([257]\.[01])?`), "E-AC-3", 0.85},
+	{AudioCodecField, tok(`(?:DD|AC-?3)([257]\.[01])?|Dolby[\s._-]?Digital`), "AC-3", 0.8},
+	{AudioCodecField, tok(`AAC(?:-?LC)?([257]\.[01])?`), "AAC", 0.85},
+	{AudioCodecField, tok(`FLAC([257]\.[01])?`), "FLAC", 0.85},
+	{AudioCodecField, tok(`L?PCM`), "PCM", 0.8},
+	{AudioCodecField, tok(`Opus`), "Opus", 0.7},
+	{AudioCodecField, tok(`MP3`), "MP3", 0.8},
+
+	{AudioChannelsField, tok(`7\.1`), "7.1", 0.8},
+	{AudioChannelsField, tok(`5\.1`), "5.1", 0.8},
+	{AudioChannelsField, tok(`2\.0`), "2.0", 0.7},
+
+	{EditionField, tok(`Directors?'?s?[\s._-]Cut`), "Director's Cut", 0.85},
+	{EditionField, tokCase(`DC`), "Director's Cut", 0.6},
+	{EditionField, tok(`Extended(?:[\s._-](?:Cut|Edition))?`), "Extended", 0.85},
+	{EditionField, tok(`Theatrical(?:[\s._-](?:Cut|Edition))?`), "Theatrical", 0.85},
+	{EditionField, tok(`Unrated`), "Unrated", 0.85},
+	{EditionField, tok(`Uncut`), "Uncut", 0.8},
+	{EditionField, tok(`Remastered`), "Remastered", 0.85},
+	{EditionField, tok(`IMAX`), "IMAX", 0.85},
+	{EditionField, tok(`Criterion(?:[\s._-]Collection)?`), "Criterion", 0.85},
+	{EditionField, tok(`(?:Special|Collectors?|Ultimate|Anniversary)[\s._-]Edition`), "Special Edition", 0.8},
+	{EditionField, tok(`Final[\s._-]Cut`), "Final Cut", 0.8},
+
+	// Tags that don't fill a field but still end the title, matched by case as they're short or common words
+	{otherField, tokCase(`PROPER|REPACK|RERIP|REAL|INTERNAL|LIMITED|COMPLETE|Complete|READNFO|NFOFIX`), "", 0},
+	{otherField, tokCase(`REMUX|Remux|HDR(?:10\+?)?|DV|DoVi|SDR|10-?[Bb]it|8-?[Bb]it|HQ|WS|FS|3D|HSBS|HOU`), "", 0},
+	{otherField, tokCase(`AMZN|NF|DSNP|HMAX|ATVP|HULU|PCOK|PMTP|iT`), "", 0},
+}
+
+// Languages are only looked for after the title, so a title like The Italian Job keeps its name.
+// The short codes are matched by case, so words like Dan or Spa in an episode title aren't taken.
+var languageTokens = []token{
+	{LanguageField, tok(`MULTi(?:[\s._-]?(?:Audio|Subs?))?`), "multi", 0.85},
+	{LanguageField, tok(`DUAL[\s._-]?Audio`), "dual", 0.85},
+	{LanguageField, tokCase(`DUAL`), "dual", 0.8},
+	{LanguageField, tok(`ENGLISH`), "en", 0.8},
+	{LanguageField, tokCase(`ENG`), "en", 0.75},
+	{LanguageField, tok(`(?:TRUE)?FRENCH`), "fr", 0.8},
+	{LanguageField, tokCase(`VFF|VFQ|VF2?|FRA?`), "fr", 0.75},
+	{LanguageField, tok(`VOSTFR`), "fr", 0.7},
+	{LanguageField, tok(`GERMAN`), "de", 0.8},
+	{LanguageField, tokCase(`DEU?|GER`), "de", 0.75},
+	{LanguageField, tok(`ITALIAN`), "it", 0.8},
+	{LanguageField, tokCase(`ITA`), "it", 0.75},
+	{LanguageField, tok(`SPANISH|CASTELLANO`), "es", 0.8},
+	{LanguageField, tokCase(`ESP|SPA`), "es", 0.75},
+	{LanguageField, tok(`LATINO`), "es", 0.7},
+	{LanguageField, tok(`PORTUGUESE|PT-?BR`), "pt", 0.8},
+	{LanguageField, tokCase(`POR`), "pt", 0.75},
+	{LanguageField, tok(`RUSSIAN`), "ru", 0.8},
+	{LanguageField, tokCase(`RUS`), "ru", 0.75},
+	{LanguageField, tok(`JAPANESE`), "ja", 0.8},
+	{LanguageField, tokCase(`JAP|JPN`), "ja", 0.75},
+	{LanguageField, tok(`KOREAN`), "ko", 0.8},
+	{LanguageField, tokCase(`KOR`), "ko", 0.75},
+	{LanguageField, tok(`CHINESE|MANDARIN|CANTONESE`), "zh", 0.8},
+	{LanguageField, tokCase(`CHI|CHS|CHT`), "zh", 0.75},
+	{LanguageField, tok(`HINDI`), "hi", 0.8},
+	{LanguageField, tokCase(`HIN`), "hi", 0.75},
+	{LanguageField, tok(`DUTCH`), "nl", 0.8},
+	{LanguageField, tokCase(`NL`), "nl", 0.7},
+	{LanguageField, tok(`SWEDISH`), "sv", 0.8},
+	{LanguageField, tokCase(`SWE`), "sv", 0.75},
+	{LanguageField, tok(`DANISH`), "da", 0.8},
+	{LanguageField, tokCase(`DAN`), "da", 0.75},
+	{LanguageField, tok(`NORWEGIAN`), "no", 0.8},
+	{LanguageField, tokCase(`NOR`), "no", 0.75},
+	{LanguageField, tok(`FINNISH`), "fi", 0.8},
+	{LanguageField, tokCase(`FIN`), "fi", 0.75},
+	{LanguageField, tok(`POLISH`), "pl", 0.8},
+	{LanguageField, tokCase(`PL`), "pl", 0.7},
+	{LanguageField, tok(`NORDIC`), "nordic", 0.7},
+}
+
+var (
+	groupRegex = regexp.MustCompile(`-([A-Za-z0-9][A-Za-z0-9_]*)(?:\[[^\]]*\])?$`) // Can be followed by a tracker tag, eg. [rarbg]
+	extRegex   = regexp.MustCompile(`(?i)\.(mkv|mp4|m4v|avi|mov|wmv|mpe?g|ts|m2ts|webm|flv|srt|ass|ssa|sub|idx|nfo)$`)
+)
@@ -0,0 +1,95 @@
+package settings
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"mediajerk/backend/fsutil"
+	"mediajerk/backend/tmdb"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+func DefaultDir() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "mediajerk"), nil
+}
+
+// Orderings remembers the episode ordering chosen for each series, eg. DVD for Firefly,
+// saved as JSON mapping TMDB series IDs to ordering names, see tmdb.Grouping.String
+type Orderings struct {
+	path string
+
+	mu     sync.Mutex
+	series map[int]tmdb.Grouping
+}
+
+// LoadOrderings reads the orderings saved at path, a missing file has none
+func LoadOrderings(path string) (*Orderings, error) {
+	o := &Orderings{path: path, series: map[int]tmdb.Grouping{}}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return o, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var names map[int]string
+	if err := json.Unmarshal(data, &names); err != nil {
+		return nil, err
+	}
+
+	for seriesId, name := range names {
+		// Skip orderings this version doesn't know, rather than losing the rest
+		if ordering, err := tmdb.ParseGrouping(name); err == nil {
+			o.series[seriesId] = ordering
+		}
+	}
+
+	return o, nil
+}
+
+// Get returns the ordering chosen for a series, tmdb.DefaultGrouping if none was
+func (o *Orderings) Get(seriesId int) tmdb.Grouping {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.series[seriesId]
+}
+
+// Set remembers the ordering for a series and saves all of them,
+// choosing tmdb.DefaultGrouping forgets the series
+func (o *Orderings) Set(seriesId int, ordering tmdb.Grouping) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if ordering == tmdb.DefaultGrouping {
+		delete(o.series, seriesId)
+	} else {
+		o.series[seriesId] = ordering
+	}
+
+	return o.save()
+}
+
+func (o *Orderings) save() error {
+	names := make(map[int]string, len(o.series))
+	for seriesId, ordering := range o.series {
+		names[seriesId] = ordering.String()
+	}
+
+	data, err := json.MarshalIndent(names, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return fsutil.WriteFile(o.path, data)
+}
@@ -0,0 +1,63 @@
+package settings
+
+import (
+	"mediajerk/backend/tmdb"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestOrderingsRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "orderings.json")
+
+	orderings, err := LoadOrderings(path)
+	if err != nil {
+		t.Fatalf("LoadOrderings of a missing file: %v", err)
+	}
+
+	if got := orderings.Get(1437); got != tmdb.DefaultGrouping {
+		t.Errorf("Get() before Set = %v, want default", got)
+	}
+
+	for seriesId, ordering := range map[int]tmdb.Grouping{1437: tmdb.DVDGrouping, 46260: tmdb.AbsoluteGrouping, 1399: tmdb.StoryArcGrouping} {
+		if err := orderings.Set(seriesId, ordering); err != nil {
+			t.Fatalf("Set(%d): %v", seriesId, err)
+		}
+	}
+
+	// Choosing the default again forgets the series
+	if err := orderings.Set(1399, tmdb.DefaultGrouping); err != nil {
+		t.Fatalf("Set(1399): %v", err)
+	}
+
+	reloaded, err := LoadOrderings(path)
+	if err != nil {
+		t.Fatalf("LoadOrderings: %v", err)
+	}
+
+	for seriesId, want := range map[int]tmdb.Grouping{1437: tmdb.DVDGrouping, 46260: tmdb.AbsoluteGrouping, 1399: tmdb.DefaultGrouping} {
+		if got := reloaded.Get(seriesId); got != want {
+			t.Errorf("reloaded Get(%d) = %v, want %v", seriesId, got, want)
+		}
+	}
+}
+
+func TestLoadOrderingsSkipsUnknown(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "orderings.json")
+	if err := os.WriteFile(path, []byte(`{"1437": "dvd", "2": "broadcast"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	orderings, err := LoadOrderings(path)
+	if err != nil {
+		t.Fatalf("LoadOrderings: %v", err)
+	}
+
+	if got := orderings.Get(1437); got != tmdb.DVDGrouping {
+		t.Errorf("Get(1437) = %v, want dvd", got)
+	}
+
+	if got := orderings.Get(2); got != tmdb.DefaultGrouping {
+		t.Errorf("Get(2) = %v, want default for an unknown ordering", got)
+	}
+}
@@ -0,0 +1,86 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// AbsoluteResolver maps the absolute episode numbers anime fansubs use, eg. Show - 137, to seasons and episodes.
+// The series' Absolute episode group is used when it has one, otherwise episodes are counted through its seasons.
+type AbsoluteResolver struct {
+	mu    sync.Mutex
+	cache seriesCache
+}
+
+func (cl *Client) NewAbsoluteResolver(seriesId string) *AbsoluteResolver {
+	return &AbsoluteResolver{cache: newSeriesCache(cl, seriesId)}
+}
+
+func (r *AbsoluteResolver) Resolve(absolute int) (*Episode, error) {
+	return r.ResolveContext(context.Background(), absolute)
+}
+
+// ResolveContext returns the episode numbered absolute, counting from 1 across the whole series.
+// A number past the last episode fails with ErrEpisodeNotFound.
+func (r *AbsoluteResolver) ResolveContext(ctx context.Context, absolute int) (*Episode, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if absolute < 1 {
+		return nil, fmt.Errorf("%w: absolute episode %d", ErrEpisodeNotFound, absolute)
+	}
+
+	group, err := r.cache.group(ctx, AbsoluteGrouping)
+	if err != nil {
+		return nil, err
+	}
+
+	// A group that hasn't caught up with the latest episodes falls back to counting
+	if group != nil {
+		if episodes := group.Episodes(); absolute <= len(episodes) {
+			return &episodes[absolute-1], nil
+		}
+	}
+
+	series, err := r.cache.details(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	seasons := make([]Season, 0, len(series.Seasons))
+	for _, season := range series.Seasons {
+		// Specials aren't counted
+		if season.SeasonNumber > 0 {
+			seasons = append(seasons, season)
+		}
+	}
+
+	sort.Slice(seasons, func(i, j int) bool {
+		return seasons[i].SeasonNumber < seasons[j].SeasonNumber
+	})
+
+	remaining := absolute
+	for _, season := range seasons {
+		if remaining > season.EpisodeCount {
+			remaining -= season.EpisodeCount
+			continue
+		}
+
+		details, err := r.cache.season(ctx, season.SeasonNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		// By position, as some series carry their episode numbers on between seasons
+		if remaining <= len(details.Episodes) {
+			episode := details.Episodes[remaining-1]
+			return &episode, nil
+		}
+
+		break
+	}
+
+	return nil, fmt.Errorf("%w: absolute episode %d", ErrEpisodeNotFound, absolute)
+}
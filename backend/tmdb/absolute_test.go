@@ -0,0 +1,66 @@
+package tmdb
+
+import (
+	"errors"
+	"maps"
+	"testing"
+)
+
+// A series whose second season carries its episode numbers on, with specials that aren't counted
+var absoluteFixtures = map[string]string{
+	"/tv/100": `{"id": 100, "seasons": [
+		{"season_number": 2, "episode_count": 2},
+		{"season_number": 0, "episode_count": 3},
+		{"season_number": 1, "episode_count": 2}
+	]}`,
+	"/tv/100/season/1": `{"season_number": 1, "episodes": [
+		{"id": 11, "season_number": 1, "episode_number": 1},
+		{"id": 12, "season_number": 1, "episode_number": 2}
+	]}`,
+	"/tv/100/season/2": `{"season_number": 2, "episodes": [
+		{"id": 23, "season_number": 2, "episode_number": 3},
+		{"id": 24, "season_number": 2, "episode_number": 4}
+	]}`,
+	"/tv/100/episode_groups": `{"id": 100, "results": []}`,
+}
+
+func TestAbsoluteResolverCountsSeasons(t *testing.T) {
+	cl := newTestClient(t, fixtures(t, absoluteFixtures, nil))
+	resolver := cl.NewAbsoluteResolver("100")
+
+	for absolute, wantID := range map[int]int{1: 11, 2: 12, 3: 23, 4: 24} {
+		episode, err := resolver.Resolve(absolute)
+		if err != nil || episode.ID != wantID {
+			t.Errorf("Resolve(%d) = %v, %v, want episode %d", absolute, episode, err, wantID)
+		}
+	}
+
+	for _, absolute := range []int{0, 5} {
+		if _, err := resolver.Resolve(absolute); !errors.Is(err, ErrEpisodeNotFound) {
+			t.Errorf("Resolve(%d) err = %v, want ErrEpisodeNotFound", absolute, err)
+		}
+	}
+}
+
+func TestAbsoluteResolverGroup(t *testing.T) {
+	// The group hasn't caught up with season 2 yet
+	bodies := maps.Clone(absoluteFixtures)
+	bodies["/tv/100/episode_groups"] = `{"id": 100, "results": [{"id": "absolute", "type": 2, "episode_count": 3}]}`
+	bodies["/tv/episode_group/absolute"] = `{"id": "absolute", "type": 2, "groups": [
+		{"name": "Specials", "order": 0, "episodes": [{"id": 1, "season_number": 0, "episode_number": 1}]},
+		{"name": "Absolute", "order": 1, "episodes": [
+			{"id": 12, "season_number": 1, "episode_number": 2, "order": 1},
+			{"id": 11, "season_number": 1, "episode_number": 1, "order": 0}
+		]}
+	]}`
+
+	cl := newTestClient(t, fixtures(t, bodies, nil))
+	resolver := cl.NewAbsoluteResolver("100")
+
+	for absolute, wantID := range map[int]int{1: 11, 2: 12, 4: 24} {
+		episode, err := resolver.Resolve(absolute)
+		if err != nil || episode.ID != wantID {
+			t.Errorf("Resolve(%d) = %v, %v, want episode %d", absolute, episode, err, wantID)
+		}
+	}
+}
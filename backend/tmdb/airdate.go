@@ -0,0 +1,109 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// AirDateResolver finds the episodes of a series by the date they aired, for daily shows named by date,
+// eg. Show.2024.03.15.Guest.Name. Seasons are fetched as needed, nearest the date first, and kept for reuse.
+type AirDateResolver struct {
+	mu    sync.Mutex
+	cache seriesCache
+}
+
+func (cl *Client) NewAirDateResolver(seriesId string) *AirDateResolver {
+	return &AirDateResolver{cache: newSeriesCache(cl, seriesId)}
+}
+
+func (r *AirDateResolver) Resolve(date time.Time) ([]Episode, error) {
+	return r.ResolveContext(context.Background(), date)
+}
+
+// ResolveContext returns the episodes that aired on date, usually one, in episode order.
+// A date with no episodes fails with ErrEpisodeNotFound.
+func (r *AirDateResolver) ResolveContext(ctx context.Context, date time.Time) ([]Episode, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	series, err := r.cache.details(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	day := date.Format(time.DateOnly)
+	for _, seasonNum := range nearestSeasons(series, date) {
+		season, err := r.cache.season(ctx, seasonNum)
+		if err != nil {
+			return nil, err
+		}
+
+		var episodes []Episode
+		for _, episode := range season.Episodes {
+			if episode.AirDate != nil && *episode.AirDate == day {
+				episodes = append(episodes, episode)
+			}
+		}
+
+		if len(episodes) > 0 {
+			return episodes, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: aired %s", ErrEpisodeNotFound, day)
+}
+
+// nearestSeasons orders the seasons to search for date: the last to start on or before it,
+// then the ones either side, in case a season's air date is off, then specials
+func nearestSeasons(series *TVSeriesDetails, date time.Time) []int {
+	type start struct {
+		num     int
+		airDate time.Time
+	}
+
+	var starts []start
+	specials := false
+	for _, season := range series.Seasons {
+		if season.SeasonNumber == 0 {
+			specials = true
+			continue
+		}
+
+		if season.AirDate == nil {
+			continue
+		}
+
+		airDate, err := time.Parse(time.DateOnly, *season.AirDate)
+		if err != nil {
+			continue
+		}
+
+		starts = append(starts, start{season.SeasonNumber, airDate})
+	}
+
+	sort.Slice(starts, func(i, j int) bool {
+		return starts[i].airDate.Before(starts[j].airDate)
+	})
+
+	// Index of the last season starting on or before the date, or the first season if it's earlier still
+	i := sort.Search(len(starts), func(i int) bool {
+		return starts[i].airDate.After(date)
+	}) - 1
+	i = max(i, 0)
+
+	var nums []int
+	for _, j := range []int{i, i + 1, i - 1} {
+		if j >= 0 && j < len(starts) {
+			nums = append(nums, starts[j].num)
+		}
+	}
+
+	if specials {
+		nums = append(nums, 0)
+	}
+
+	return nums
+}
@@ -0,0 +1,146 @@
+package tmdb
+
+import (
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func date(s string) time.Time {
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		panic(err)
+	}
+
+	return t
+}
+
+func TestNearestSeasons(t *testing.T) {
+	airDate := func(s string) *string { return &s }
+	series := &TVSeriesDetails{Seasons: []Season{
+		{SeasonNumber: 3, AirDate: airDate("2022-01-03")},
+		{SeasonNumber: 0, AirDate: airDate("2019-12-25")},
+		{SeasonNumber: 1, AirDate: airDate("2020-01-06")},
+		{SeasonNumber: 4}, // Announced, without a date yet
+		{SeasonNumber: 2, AirDate: airDate("2021-01-04")},
+	}}
+
+	tests := []struct {
+		date string
+		want []int
+	}{
+		{"2021-06-15", []int{2, 3, 1, 0}},
+		{"2021-01-04", []int{2, 3, 1, 0}}, // The day a season starts is in it
+		{"2021-01-03", []int{1, 2, 0}},
+		{"2019-01-01", []int{1, 2, 0}}, // Before the first season
+		{"2030-01-01", []int{3, 2, 0}}, // After the last
+	}
+
+	for _, tt := range tests {
+		if got := nearestSeasons(series, date(tt.date)); !slices.Equal(got, tt.want) {
+			t.Errorf("nearestSeasons(%s) = %v, want %v", tt.date, got, tt.want)
+		}
+	}
+
+	if got := nearestSeasons(&TVSeriesDetails{}, date("2021-01-01")); len(got) != 0 {
+		t.Errorf("nearestSeasons() without seasons = %v, want none", got)
+	}
+}
+
+// A daily show whose third season is listed as starting after its first episode aired
+var airDateFixtures = map[string]string{
+	"/tv/200": `{"id": 200, "seasons": [
+		{"season_number": 0, "air_date": "2020-12-24"},
+		{"season_number": 1, "air_date": "2020-01-06"},
+		{"season_number": 2, "air_date": "2021-01-04"},
+		{"season_number": 3, "air_date": "2022-01-03"}
+	]}`,
+	"/tv/200/season/0": `{"season_number": 0, "episodes": [
+		{"id": 1, "season_number": 0, "episode_number": 1, "air_date": "2020-12-24"}
+	]}`,
+	"/tv/200/season/1": `{"season_number": 1, "episodes": [
+		{"id": 101, "season_number": 1, "episode_number": 1, "air_date": "2020-01-06"},
+		{"id": 102, "season_number": 1, "episode_number": 2, "air_date": "2020-01-07"}
+	]}`,
+	"/tv/200/season/2": `{"season_number": 2, "episodes": [
+		{"id": 201, "season_number": 2, "episode_number": 1, "air_date": "2021-01-04"},
+		{"id": 202, "season_number": 2, "episode_number": 2, "air_date": "2021-01-05"},
+		{"id": 203, "season_number": 2, "episode_number": 3, "air_date": "2021-01-05"},
+		{"id": 204, "season_number": 2, "episode_number": 4, "air_date": null}
+	]}`,
+	"/tv/200/season/3": `{"season_number": 3, "episodes": [
+		{"id": 301, "season_number": 3, "episode_number": 1, "air_date": "2021-12-27"}
+	]}`,
+}
+
+func TestAirDateResolver(t *testing.T) {
+	cl := newTestClient(t, fixtures(t, airDateFixtures, nil))
+	resolver := cl.NewAirDateResolver("200")
+
+	tests := []struct {
+		date    string
+		wantIDs []int
+	}{
+		{"2020-01-07", []int{102}},
+		{"2021-01-05", []int{202, 203}}, // A double bill
+		{"2021-12-27", []int{301}},      // Found in the season after the one its date falls in
+		{"2020-12-24", []int{1}},        // A special
+	}
+
+	for _, tt := range tests {
+		episodes, err := resolver.Resolve(date(tt.date))
+		if err != nil {
+			t.Errorf("Resolve(%s): %v", tt.date, err)
+			continue
+		}
+
+		var ids []int
+		for _, episode := range episodes {
+			ids = append(ids, episode.ID)
+		}
+
+		if !slices.Equal(ids, tt.wantIDs) {
+			t.Errorf("Resolve(%s) = %v, want %v", tt.date, ids, tt.wantIDs)
+		}
+	}
+
+	for _, day := range []string{"2021-01-06", "2019-06-01"} {
+		if _, err := resolver.Resolve(date(day)); !errors.Is(err, ErrEpisodeNotFound) {
+			t.Errorf("Resolve(%s) err = %v, want ErrEpisodeNotFound", day, err)
+		}
+	}
+}
+
+func TestSeriesCacheExpiry(t *testing.T) {
+	details, seasons := &atomic.Int32{}, &atomic.Int32{}
+	cl := newTestClient(t, fixtures(t, airDateFixtures, map[string]*atomic.Int32{
+		"/tv/200":          details,
+		"/tv/200/season/1": seasons,
+	}))
+	resolver := cl.NewAirDateResolver("200")
+
+	for range 2 {
+		if _, err := resolver.Resolve(date("2020-01-06")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if details.Load() != 1 || seasons.Load() != 1 {
+		t.Fatalf("fetched the series %d and season %d times, want once each", details.Load(), seasons.Load())
+	}
+
+	// Once they've been kept for longer than seriesCacheTTL they're fetched again
+	stale := time.Now().Add(-seriesCacheTTL - time.Minute)
+	resolver.cache.series.fetched = stale
+	resolver.cache.seasons[1] = expiring[*TVSeasonDetails]{resolver.cache.seasons[1].value, stale}
+
+	if _, err := resolver.Resolve(date("2020-01-06")); err != nil {
+		t.Fatal(err)
+	}
+
+	if details.Load() != 2 || seasons.Load() != 2 {
+		t.Errorf("fetched the series %d and season %d times, want twice each after expiry", details.Load(), seasons.Load())
+	}
+}
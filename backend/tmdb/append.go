@@ -0,0 +1,131 @@
+package tmdb
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// TMDB ignores append_to_response keys past this many
+const MaxAppend = 20
+
+var ErrTooManyAppends = errors.New("tmdb: too many append_to_response keys")
+
+// Appendable is anything that can be passed to Append
+type Appendable interface {
+	appendKeys() []string
+}
+
+// AppendKey names a sub-request that can be appended to a details request,
+// see https://developer.themoviedb.org/docs/append-to-response
+type AppendKey string
+
+const (
+	Credits           AppendKey = "credits"
+	Images            AppendKey = "images"
+	Videos            AppendKey = "videos"
+	ExternalIDs       AppendKey = "external_ids"
+	Translations      AppendKey = "translations"
+	AlternativeTitles AppendKey = "alternative_titles"
+	Keywords          AppendKey = "keywords"
+	ReleaseDates      AppendKey = "release_dates"    // Movies only
+	ContentRatings    AppendKey = "content_ratings"  // Series only
+	EpisodeGroups     AppendKey = "episode_groups"   // Series only
+	CombinedCredits   AppendKey = "combined_credits" // People only
+	WatchProviders    AppendKey = "watch/providers"
+)
+
+func (k AppendKey) appendKeys() []string {
+	return []string{string(k)}
+}
+
+type seasonKeys []int
+
+func (s seasonKeys) appendKeys() []string {
+	keys := make([]string, len(s))
+	for i, num := range s {
+		keys[i] = seasonKeyPrefix + strconv.Itoa(num)
+	}
+
+	return keys
+}
+
+const seasonKeyPrefix = "season/"
+
+// Seasons appends the full details of the given seasons to a series request,
+// decoded into TVSeriesDetails.FullSeasons
+func Seasons(nums ...int) Appendable {
+	return seasonKeys(nums)
+}
+
+// SeasonRange appends seasons from to to inclusive, see Seasons
+func SeasonRange(from int, to int) Appendable {
+	var nums seasonKeys
+	for num := from; num <= to; num++ {
+		nums = append(nums, num)
+	}
+
+	return nums
+}
+
+// Append joins items into a DetailsParams.AppendToResponse value, failing with ErrTooManyAppends
+// when they come to more than MaxAppend keys. Season keys are the exception, TVSeries splits
+// requests for more than MaxAppend of them over several calls.
+func Append(items ...Appendable) (string, error) {
+	var keys []string
+	for _, item := range items {
+		keys = append(keys, item.appendKeys()...)
+	}
+
+	value := strings.Join(keys, ",")
+	if _, err := chunkAppend(splitAppend(value)); err != nil {
+		return "", err
+	}
+
+	return value, nil
+}
+
+// splitAppend parses an append_to_response value into its keys, dropping blanks and duplicates
+func splitAppend(value string) []string {
+	var keys []string
+	seen := map[string]bool{}
+	for _, key := range strings.Split(value, ",") {
+		key = strings.TrimSpace(key)
+		if key == "" || seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// chunkAppend splits keys into groups of up to MaxAppend,
+// the first group holds every non season key so they're only requested once
+func chunkAppend(keys []string) ([][]string, error) {
+	var first, seasons []string
+	for _, key := range keys {
+		if strings.HasPrefix(key, seasonKeyPrefix) {
+			seasons = append(seasons, key)
+		} else {
+			first = append(first, key)
+		}
+	}
+
+	if len(first) > MaxAppend {
+		return nil, ErrTooManyAppends
+	}
+
+	// Fill the first group up with seasons, then chunk the rest
+	fill := min(MaxAppend-len(first), len(seasons))
+	chunks := [][]string{append(first, seasons[:fill]...)}
+	for rest := seasons[fill:]; len(rest) > 0; {
+		n := min(MaxAppend, len(rest))
+		chunks = append(chunks, rest[:n])
+		rest = rest[n:]
+	}
+
+	return chunks, nil
+}
@@ -0,0 +1,95 @@
+package tmdb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestAppend(t *testing.T) {
+	value, err := Append(Credits, Images, Seasons(1, 2))
+	if err != nil || value != "credits,images,season/1,season/2" {
+		t.Errorf("Append() = %q, %v, want the joined keys", value, err)
+	}
+
+	// TVSeries splits the seasons over several calls
+	if _, err := Append(Credits, SeasonRange(1, MaxAppend)); err != nil {
+		t.Errorf("Append() of many seasons err = %v, want none", err)
+	}
+
+	keys := make([]Appendable, MaxAppend+1)
+	for i := range keys {
+		keys[i] = AppendKey(fmt.Sprintf("key%d", i))
+	}
+
+	if _, err := Append(keys...); !errors.Is(err, ErrTooManyAppends) {
+		t.Errorf("Append() over MaxAppend err = %v, want ErrTooManyAppends", err)
+	}
+}
+
+func TestTVSeasonTooManyAppends(t *testing.T) {
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("requested %s, want no request", r.URL.Path)
+	})
+
+	params := DetailsParams{AppendToResponse: strings.Join(SeasonRange(1, MaxAppend+1).appendKeys(), ",")}
+	if _, err := cl.TVSeasonContext(context.Background(), "1", 1, params); !errors.Is(err, ErrTooManyAppends) {
+		t.Errorf("err = %v, want ErrTooManyAppends", err)
+	}
+}
+
+func TestTVSeriesManySeasons(t *testing.T) {
+	var requests atomic.Int32
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		keys := strings.Split(r.URL.Query().Get("append_to_response"), ",")
+		if len(keys) > MaxAppend {
+			t.Errorf("requested %d keys, want at most %d", len(keys), MaxAppend)
+		}
+
+		body := map[string]any{"id": 1, "name": "Long Runner"}
+		for _, key := range keys {
+			if num, ok := strings.CutPrefix(key, seasonKeyPrefix); ok {
+				body[key] = json.RawMessage(`{"season_number": ` + num + `}`)
+			} else {
+				body[key] = map[string]any{"id": 1}
+			}
+		}
+
+		json.NewEncoder(w).Encode(body)
+	})
+
+	value, err := Append(Credits, SeasonRange(0, 24))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	series, err := cl.TVSeriesContext(context.Background(), "1", DetailsParams{AppendToResponse: value})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if requests.Load() != 2 {
+		t.Errorf("made %d requests, want 2 for credits and 25 seasons", requests.Load())
+	}
+
+	if series.Name != "Long Runner" || series.Credits == nil {
+		t.Errorf("got %q with credits %v, want the first response's details", series.Name, series.Credits)
+	}
+
+	if len(series.FullSeasons) != 25 {
+		t.Fatalf("got %d FullSeasons, want 25", len(series.FullSeasons))
+	}
+
+	for i, season := range series.FullSeasons {
+		if season.SeasonNumber != i {
+			t.Errorf("FullSeasons[%d] is season %d, want them merged in order", i, season.SeasonNumber)
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package tmdb
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mediajerk/backend/fsutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrOffline is returned in offline mode when a response isn't cached
+var ErrOffline = errors.New("tmdb: offline and response not cached")
+
+// Cache stores raw TMDB response bodies by request key
+type Cache interface {
+	// Get returns the cached body for key and when it expires.
+	// Expired entries may still be returned, the client decides whether to use them.
+	Get(key string) (data []byte, expires time.Time, ok bool)
+	Set(key string, data []byte, expires time.Time) error
+}
+
+// TTLFunc decides how long a successful response for path should be cached,
+// a zero or negative duration skips caching it
+type TTLFunc func(path string, data []byte) time.Duration
+
+// cacheKey identifies a request by its path and encoded query, which excludes any credentials
+func cacheKey(path string, params url.Values) string {
+	key := strings.Trim(path, "/")
+	if len(params) > 0 {
+		key += "?" + params.Encode()
+	}
+
+	return key
+}
+
+// cachedResponse wraps a cached body so it can be handled like a live response
+func cachedResponse(data []byte) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Header:        http.Header{"X-Cache": {"HIT"}},
+		Body:          io.NopCloser(bytes.NewReader(data)),
+		ContentLength: int64(len(data)),
+	}
+}
+
+var (
+	seriesPathRegex = regexp.MustCompile(`^tv/\d+$`)
+	seasonPathRegex = regexp.MustCompile(`^tv/\d+/season/\d+$`)
+)
+
+// DefaultTTL caches lookups of things that are unlikely to change for longer,
+// eg. ended series and seasons that finished airing a while ago
+func DefaultTTL(path string, data []byte) time.Duration {
+	const day = 24 * time.Hour
+
+	switch {
+	case strings.HasPrefix(path, "search/"), strings.HasPrefix(path, "discover/"):
+		return day
+	case strings.HasPrefix(path, "trending/"):
+		return 6 * time.Hour
+	case strings.HasSuffix(path, "/watch/providers"):
+		return day
+	case seriesPathRegex.MatchString(path):
+		var series struct {
+			Status       string `json:"status"`
+			InProduction bool   `json:"in_production"`
+		}
+		if json.Unmarshal(data, &series) == nil && !series.InProduction &&
+			(series.Status == "Ended" || series.Status == "Canceled") {
+			return 30 * day
+		}
+
+		return 12 * time.Hour
+	case seasonPathRegex.MatchString(path):
+		var season struct {
+			Episodes []struct {
+				AirDate *string `json:"air_date"`
+			} `json:"episodes"`
+		}
+		if json.Unmarshal(data, &season) != nil || len(season.Episodes) == 0 {
+			return 12 * time.Hour
+		}
+
+		last := season.Episodes[len(season.Episodes)-1].AirDate
+		if last == nil {
+			return 12 * time.Hour
+		}
+
+		aired, err := time.Parse(time.DateOnly, *last)
+		if err != nil || time.Since(aired) < 30*day {
+			return 12 * time.Hour
+		}
+
+		return 30 * day
+	case strings.HasPrefix(path, "movie/"), strings.HasPrefix(path, "tv/episode_group/"):
+		return 7 * day
+	case strings.HasPrefix(path, "configuration"), strings.HasPrefix(path, "certification/"),
+		strings.HasPrefix(path, "genre/"):
+		return 3 * day
+	}
+
+	return day
+}
+
+// MemoryCache is an in-process Cache, mostly useful for tests and short lived clients
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]memoryEntry
+}
+
+type memoryEntry struct {
+	data    []byte
+	expires time.Time
+}
+
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: map[string]memoryEntry{}}
+}
+
+func (c *MemoryCache) Get(key string) ([]byte, time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	return entry.data, entry.expires, ok
+}
+
+func (c *MemoryCache) Set(key string, data []byte, expires time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = memoryEntry{data, expires}
+	return nil
+}
+
+// DiskCache is a Cache storing one file per response in a directory.
+// Each file starts with a header line holding the expiry and key, followed by the raw body.
+type DiskCache struct {
+	dir string
+}
+
+// DefaultCacheDir returns the tmdb cache directory inside the user's cache dir
+func DefaultCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "mediajerk", "tmdb"), nil
+}
+
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &DiskCache{dir: dir}, nil
+}
+
+func (c *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	name := hex.EncodeToString(sum[:])
+
+	// Fan out into subdirectories to keep directory listings small
+	return filepath.Join(c.dir, name[:2], name)
+}
+
+func (c *DiskCache) Get(key string) ([]byte, time.Time, bool) {
+	file, err := os.Open(c.path(key))
+	if err != nil {
+		return nil, time.Time{}, false
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	header, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, time.Time{}, false
+	}
+
+	expiresStr, storedKey, ok := strings.Cut(strings.TrimSuffix(header, "\n"), " ")
+	if !ok || storedKey != key {
+		return nil, time.Time{}, false
+	}
+
+	expires, err := strconv.ParseInt(expiresStr, 10, 64)
+	if err != nil {
+		return nil, time.Time{}, false
+	}
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, time.Time{}, false
+	}
+
+	return data, time.Unix(expires, 0), true
+}
+
+func (c *DiskCache) Set(key string, data []byte, expires time.Time) error {
+	return fsutil.WriteAtomic(c.path(key), func(w io.Writer) error {
+		if _, err := fmt.Fprintf(w, "%d %s\n", expires.Unix(), key); err != nil {
+			return err
+		}
+
+		_, err := w.Write(data)
+		return err
+	})
+}
+
+// Clear removes every cached entry
+func (c *DiskCache) Clear() error {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if err := os.RemoveAll(filepath.Join(c.dir, entry.Name())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingServer answers every request with the number of requests so far as the ID, or with status when it's set
+func countingServer(requests *atomic.Int32, status *atomic.Int32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		n := requests.Add(1)
+		if code := status.Load(); code != 0 {
+			w.WriteHeader(int(code))
+			return
+		}
+
+		fmt.Fprintf(w, `{"id": %d}`, n)
+	}
+}
+
+func TestCacheExpiry(t *testing.T) {
+	var requests, status atomic.Int32
+	cache := NewMemoryCache()
+	cl := newTestClient(t, countingServer(&requests, &status), WithCache(cache), WithCacheTTL(func(string, []byte) time.Duration {
+		return time.Hour
+	}))
+
+	for range 2 {
+		series, err := cl.TVSeriesByIDContext(context.Background(), "1")
+		if err != nil || series.ID != 1 {
+			t.Fatalf("got series %v, %v, want the first response", series, err)
+		}
+	}
+
+	if requests.Load() != 1 {
+		t.Fatalf("made %d requests, want 1 with the second served from the cache", requests.Load())
+	}
+
+	// Once expired it's fetched again
+	data, _, _ := cache.Get(cacheKey("tv/1", nil))
+	cache.Set(cacheKey("tv/1", nil), data, time.Now().Add(-time.Minute))
+
+	series, err := cl.TVSeriesByIDContext(context.Background(), "1")
+	if err != nil || series.ID != 2 {
+		t.Errorf("got series %v, %v, want the second response", series, err)
+	}
+}
+
+func TestCacheOffline(t *testing.T) {
+	var requests, status atomic.Int32
+	cache := NewMemoryCache()
+	cache.Set(cacheKey("tv/1", nil), []byte(`{"id": 1}`), time.Now().Add(-time.Hour))
+	cl := newTestClient(t, countingServer(&requests, &status), WithCache(cache), WithOffline(true))
+
+	// Expired entries are still served offline
+	series, err := cl.TVSeriesByIDContext(context.Background(), "1")
+	if err != nil || series.ID != 1 {
+		t.Errorf("got series %v, %v, want the cached one", series, err)
+	}
+
+	if _, err := cl.TVSeriesByIDContext(context.Background(), "2"); !errors.Is(err, ErrOffline) {
+		t.Errorf("uncached err = %v, want ErrOffline", err)
+	}
+
+	if requests.Load() != 0 {
+		t.Errorf("made %d requests offline, want none", requests.Load())
+	}
+}
+
+func TestCacheStaleFallback(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		closed bool // The server is gone, so requests fail without a response
+	}{
+		{"server error", http.StatusBadGateway, false},
+		{"rate limited", http.StatusTooManyRequests, false},
+		{"network error", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests, status atomic.Int32
+			status.Store(int32(tt.status))
+
+			cache := NewMemoryCache()
+			cache.Set(cacheKey("tv/1", nil), []byte(`{"id": 1}`), time.Now().Add(-time.Hour))
+
+			cl := newTestClient(t, countingServer(&requests, &status), WithCache(cache), WithRetryPolicy(NoRetry))
+			if tt.closed {
+				server := httptest.NewServer(http.NotFoundHandler())
+				server.Close()
+				cl = NewClient("key", WithBaseURL(server.URL), WithRateLimit(0, 0), WithCache(cache), WithRetryPolicy(NoRetry))
+			}
+
+			series, err := cl.TVSeriesByIDContext(context.Background(), "1")
+			if err != nil || series.ID != 1 {
+				t.Errorf("got series %v, %v, want the stale cached one", series, err)
+			}
+
+			// Without a cached entry the failure comes through
+			if _, err := cl.TVSeriesByIDContext(context.Background(), "2"); err == nil {
+				t.Error("uncached request succeeded, want its error")
+			}
+		})
+	}
+}
+
+func TestCacheNotFoundNotServedStale(t *testing.T) {
+	var requests, status atomic.Int32
+	status.Store(http.StatusNotFound)
+
+	cache := NewMemoryCache()
+	cache.Set(cacheKey("tv/1", nil), []byte(`{"id": 1}`), time.Now().Add(-time.Hour))
+	cl := newTestClient(t, countingServer(&requests, &status), WithCache(cache))
+
+	var apiErr *APIError
+	if _, err := cl.TVSeriesByIDContext(context.Background(), "1"); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("err = %v, want a 404 APIError as the series is gone", err)
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := NewDiskCache(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expires := time.Now().Add(time.Hour).Truncate(time.Second)
+	if err := cache.Set("tv/1?language=en", []byte(`{"id": 1}`), expires); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	// A new cache over the same directory sees the entry
+	reopened, _ := NewDiskCache(dir)
+	data, gotExpires, ok := reopened.Get("tv/1?language=en")
+	if !ok || string(data) != `{"id": 1}` || !gotExpires.Equal(expires) {
+		t.Errorf("Get = %s, %v, %v, want the entry expiring %v", data, gotExpires, ok, expires)
+	}
+
+	if _, _, ok := reopened.Get("tv/2"); ok {
+		t.Error("Get of a missing key hit")
+	}
+
+	if err := reopened.Clear(); err != nil {
+		t.Fatalf("Clear: %v", err)
+	}
+
+	if _, _, ok := reopened.Get("tv/1?language=en"); ok {
+		t.Error("Get after Clear hit")
+	}
+}
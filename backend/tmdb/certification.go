@@ -0,0 +1,178 @@
+package tmdb
+
+import "context"
+
+// ReleaseType is the kind of a movie release, see https://developer.themoviedb.org/reference/movie-release-dates
+type ReleaseType int
+
+const (
+	PremiereRelease ReleaseType = iota + 1
+	TheatricalLimitedRelease
+	TheatricalRelease
+	DigitalRelease
+	PhysicalRelease
+	TVRelease
+)
+
+// certificationPreference orders release types by how representative their certification is
+var certificationPreference = []ReleaseType{
+	TheatricalRelease,
+	TheatricalLimitedRelease,
+	DigitalRelease,
+	PhysicalRelease,
+	TVRelease,
+	PremiereRelease,
+}
+
+type ReleaseDate struct {
+	Certification string      `json:"certification"`
+	Descriptors   []string    `json:"descriptors"`
+	ISO639_1      string      `json:"iso_639_1"`
+	Note          string      `json:"note"`
+	ReleaseDate   string      `json:"release_date"`
+	Type          ReleaseType `json:"type"`
+}
+
+type CountryReleaseDates struct {
+	ISO3166_1    string        `json:"iso_3166_1"`
+	ReleaseDates []ReleaseDate `json:"release_dates"`
+}
+
+type ReleaseDatesResponse struct {
+	Results []CountryReleaseDates `json:"results"`
+}
+
+// Country returns the releases in country, an ISO 3166-1 code like US
+func (r *ReleaseDatesResponse) Country(country string) []ReleaseDate {
+	for _, result := range r.Results {
+		if result.ISO3166_1 == country {
+			return result.ReleaseDates
+		}
+	}
+
+	return nil
+}
+
+// Certification picks the certification of a movie in country, eg. PG-13 in US,
+// preferring theatrical releases over others. Returns an empty string if it has none there.
+func (r *ReleaseDatesResponse) Certification(country string) string {
+	releases := r.Country(country)
+	for _, releaseType := range certificationPreference {
+		for _, release := range releases {
+			if release.Type == releaseType && release.Certification != "" {
+				return release.Certification
+			}
+		}
+	}
+
+	return ""
+}
+
+// FirstRelease returns the earliest release of releaseType in country, or nil if there isn't one
+func (r *ReleaseDatesResponse) FirstRelease(country string, releaseType ReleaseType) *ReleaseDate {
+	var first *ReleaseDate
+	releases := r.Country(country)
+	for i := range releases {
+		release := &releases[i]
+		if release.Type == releaseType && (first == nil || release.ReleaseDate < first.ReleaseDate) {
+			first = release
+		}
+	}
+
+	return first
+}
+
+type ContentRating struct {
+	Descriptors []string `json:"descriptors"`
+	ISO3166_1   string   `json:"iso_3166_1"`
+	Rating      string   `json:"rating"`
+}
+
+type ContentRatingsResponse struct {
+	Results []ContentRating `json:"results"`
+}
+
+// Rating returns the content rating of a series in country, eg. TV-MA in US,
+// or an empty string if it has none there
+func (c *ContentRatingsResponse) Rating(country string) string {
+	for _, result := range c.Results {
+		if result.ISO3166_1 == country {
+			return result.Rating
+		}
+	}
+
+	return ""
+}
+
+type Certification struct {
+	Certification string `json:"certification"`
+	Meaning       string `json:"meaning"`
+	Order         int    `json:"order"`
+}
+
+// CertificationsResponse lists the certifications in use by country
+type CertificationsResponse struct {
+	Certifications map[string][]Certification `json:"certifications"`
+}
+
+// Certification picks the movie's certification in country from the appended release_dates
+func (m *MovieDetails) Certification(country string) string {
+	if m.ReleaseDates == nil {
+		return ""
+	}
+
+	return m.ReleaseDates.Certification(country)
+}
+
+// ContentRating picks the series' rating in country from the appended content_ratings
+func (t *TVSeriesDetails) ContentRating(country string) string {
+	if t.ContentRatings == nil {
+		return ""
+	}
+
+	return t.ContentRatings.Rating(country)
+}
+
+func (cl *Client) MovieReleaseDates(movieId string) (*ReleaseDatesResponse, error) {
+	return cl.MovieReleaseDatesContext(context.Background(), movieId)
+}
+
+// https://developer.themoviedb.org/reference/movie-release-dates
+// https://api.themoviedb.org/3/movie/{movie_id}/release_dates
+func (cl *Client) MovieReleaseDatesContext(ctx context.Context, movieId string) (*ReleaseDatesResponse, error) {
+	path := "movie/" + movieId + "/release_dates"
+	return getJSON[ReleaseDatesResponse](ctx, cl, path, nil)
+}
+
+func (cl *Client) TVSeriesContentRatings(seriesId string) (*ContentRatingsResponse, error) {
+	return cl.TVSeriesContentRatingsContext(context.Background(), seriesId)
+}
+
+// https://developer.themoviedb.org/reference/tv-series-content-ratings
+// https://api.themoviedb.org/3/tv/{series_id}/content_ratings
+func (cl *Client) TVSeriesContentRatingsContext(ctx context.Context, seriesId string) (*ContentRatingsResponse, error) {
+	path := "tv/" + seriesId + "/content_ratings"
+	return getJSON[ContentRatingsResponse](ctx, cl, path, nil)
+}
+
+func (cl *Client) MovieCertifications() (*CertificationsResponse, error) {
+	return cl.MovieCertificationsContext(context.Background())
+}
+
+// https://developer.themoviedb.org/reference/certification-movie-list
+// https://api.themoviedb.org/3/certification/movie/list
+func (cl *Client) MovieCertificationsContext(ctx context.Context) (*CertificationsResponse, error) {
+	path := "certification/movie/list"
+	return getJSON[CertificationsResponse](ctx, cl, path, nil)
+}
+
+func (cl *Client) TVCertifications() (*CertificationsResponse, error) {
+	return cl.TVCertificationsContext(context.Background())
+}
+
+// https://developer.themoviedb.org/reference/certifications-tv-list
+// https://api.themoviedb.org/3/certification/tv/list
+func (cl *Client) TVCertificationsContext(ctx context.Context) (*CertificationsResponse, error) {
+	path := "certification/tv/list"
+	return getJSON[CertificationsResponse](ctx, cl, path, nil)
+}
@@ -0,0 +1,88 @@
+package tmdb
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// Trimmed from a real release_dates response
+const releaseDatesJSON = `{"results": [
+	{"iso_3166_1": "US", "release_dates": [
+		{"certification": "", "type": 1, "release_date": "2019-05-30T00:00:00.000Z", "note": "Cannes"},
+		{"certification": "R", "type": 2, "release_date": "2019-10-11T00:00:00.000Z"},
+		{"certification": "", "type": 3, "release_date": "2019-10-18T00:00:00.000Z"},
+		{"certification": "R", "type": 4, "release_date": "2020-01-28T00:00:00.000Z"}
+	]},
+	{"iso_3166_1": "GB", "release_dates": [
+		{"certification": "12", "type": 5, "release_date": "2020-02-24T00:00:00.000Z"},
+		{"certification": "15", "type": 3, "release_date": "2020-02-07T00:00:00.000Z"}
+	]},
+	{"iso_3166_1": "KR", "release_dates": [
+		{"certification": "15", "type": 1, "release_date": "2019-05-21T00:00:00.000Z"},
+		{"certification": "", "type": 3, "release_date": "2019-05-30T00:00:00.000Z"},
+		{"certification": "", "type": 3, "release_date": "2019-05-29T00:00:00.000Z"}
+	]},
+	{"iso_3166_1": "FR", "release_dates": [
+		{"certification": "", "type": 3, "release_date": "2019-06-05T00:00:00.000Z"}
+	]}
+]}`
+
+func TestCertification(t *testing.T) {
+	var dates ReleaseDatesResponse
+	if err := json.Unmarshal([]byte(releaseDatesJSON), &dates); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		country, want string
+	}{
+		{"GB", "15"}, // The theatrical release over the later physical one
+		{"US", "R"},  // The theatrical release has none, so the limited one's
+		{"KR", "15"}, // Only the premiere was rated
+		{"FR", ""},   // Released, but unrated
+		{"DE", ""},   // Not released there
+	}
+
+	for _, tt := range tests {
+		if got := dates.Certification(tt.country); got != tt.want {
+			t.Errorf("Certification(%s) = %q, want %q", tt.country, got, tt.want)
+		}
+	}
+
+	movie := MovieDetails{ReleaseDates: &dates}
+	if got := movie.Certification("GB"); got != "15" {
+		t.Errorf("MovieDetails.Certification(GB) = %q, want 15", got)
+	}
+
+	if got := (&MovieDetails{}).Certification("GB"); got != "" {
+		t.Errorf("Certification() without release dates = %q, want none", got)
+	}
+}
+
+func TestFirstRelease(t *testing.T) {
+	var dates ReleaseDatesResponse
+	if err := json.Unmarshal([]byte(releaseDatesJSON), &dates); err != nil {
+		t.Fatal(err)
+	}
+
+	if first := dates.FirstRelease("KR", TheatricalRelease); first == nil || first.ReleaseDate != "2019-05-29T00:00:00.000Z" {
+		t.Errorf("FirstRelease(KR) = %v, want the earlier of the two", first)
+	}
+
+	if first := dates.FirstRelease("FR", DigitalRelease); first != nil {
+		t.Errorf("FirstRelease(FR, digital) = %v, want nil", first)
+	}
+}
+
+func TestContentRating(t *testing.T) {
+	series := TVSeriesDetails{ContentRatings: &ContentRatingsResponse{Results: []ContentRating{
+		{ISO3166_1: "US", Rating: "TV-MA"},
+		{ISO3166_1: "DE", Rating: "16"},
+	}}}
+
+	for country, want := range map[string]string{"US": "TV-MA", "DE": "16", "JP": ""} {
+		if got := series.ContentRating(country); got != want {
+			t.Errorf("ContentRating(%s) = %q, want %q", country, got, want)
+		}
+	}
+}
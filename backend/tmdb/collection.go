@@ -0,0 +1,73 @@
+package tmdb
+
+import (
+	"context"
+	"sort"
+)
+
+type CollectionParams struct {
+	Language string `url:"language,omitempty"`
+}
+
+type CollectionSearchParams struct {
+	CommonSearchParams
+	Region string `url:"region,omitempty"`
+}
+
+type CollectionDetails struct {
+	ID           int     `json:"id"`
+	Name         string  `json:"name"`
+	Overview     string  `json:"overview"`
+	PosterPath   *string `json:"poster_path"`
+	BackdropPath *string `json:"backdrop_path"`
+	Parts        []Movie `json:"parts"`
+}
+
+// PartsByRelease returns the collection's movies in release order, unreleased ones last
+func (c *CollectionDetails) PartsByRelease() []Movie {
+	parts := make([]Movie, len(c.Parts))
+	copy(parts, c.Parts)
+
+	sort.SliceStable(parts, func(i, j int) bool {
+		a, b := parts[i].ReleaseDate, parts[j].ReleaseDate
+		if a == "" || b == "" {
+			return a != "" && b == ""
+		}
+
+		return a < b
+	})
+
+	return parts
+}
+
+// PartNumber returns the 1 based position of a movie in release order,
+// or 0 if it isn't part of the collection
+func (c *CollectionDetails) PartNumber(movieId int) int {
+	for i, part := range c.PartsByRelease() {
+		if part.ID == movieId {
+			return i + 1
+		}
+	}
+
+	return 0
+}
+
+func (cl *Client) Collection(collectionId string, params CollectionParams) (*CollectionDetails, error) {
+	return cl.CollectionContext(context.Background(), collectionId, params)
+}
+
+// https://developer.themoviedb.org/reference/collection-details
+// https://api.themoviedb.org/3/collection/{collection_id}
+func (cl *Client) CollectionContext(ctx context.Context, collectionId string, params CollectionParams) (*CollectionDetails, error) {
+	path := "collection/" + collectionId
+	return getJSON[CollectionDetails](ctx, cl, path, params)
+}
+
+// Convenience methods for simple calls without parameters
+func (cl *Client) CollectionByID(collectionId string) (*CollectionDetails, error) {
+	return cl.CollectionByIDContext(context.Background(), collectionId)
+}
+
+func (cl *Client) CollectionByIDContext(ctx context.Context, collectionId string) (*CollectionDetails, error) {
+	return cl.CollectionContext(ctx, collectionId, CollectionParams{})
+}
@@ -0,0 +1,185 @@
+package tmdb
+
+import (
+	"context"
+	"encoding/json"
+	"mediajerk/backend/non"
+	"mediajerk/backend/num"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type ImageConfiguration struct {
+	BaseURL       string   `json:"base_url"`
+	SecureBaseURL string   `json:"secure_base_url"`
+	BackdropSizes []string `json:"backdrop_sizes"`
+	LogoSizes     []string `json:"logo_sizes"`
+	PosterSizes   []string `json:"poster_sizes"`
+	ProfileSizes  []string `json:"profile_sizes"`
+	StillSizes    []string `json:"still_sizes"`
+}
+
+type Configuration struct {
+	Images     ImageConfiguration `json:"images"`
+	ChangeKeys []string           `json:"change_keys"`
+}
+
+func (cl *Client) Configuration() (*Configuration, error) {
+	return cl.ConfigurationContext(context.Background())
+}
+
+// https://developer.themoviedb.org/reference/configuration-details
+// https://api.themoviedb.org/3/configuration
+func (cl *Client) ConfigurationContext(ctx context.Context) (*Configuration, error) {
+	path := "configuration"
+	return getJSON[Configuration](ctx, cl, path, nil)
+}
+
+// ImageConfig returns the image configuration, fetching it on first use and again once it's been kept
+// for as long as the client's TTLFunc caches the configuration for. A failed refetch keeps using the old one.
+func (cl *Client) ImageConfig(ctx context.Context) (*ImageConfiguration, error) {
+	cl.configMu.Lock()
+	defer cl.configMu.Unlock()
+
+	if cl.config == nil || !time.Now().Before(cl.configExpires) {
+		path := "configuration"
+		config, err := getJSON[Configuration](ctx, cl, path, nil)
+		if err != nil && cl.config == nil {
+			return nil, err
+		}
+
+		if err == nil {
+			data, _ := json.Marshal(config)
+			cl.config = config
+			cl.configExpires = time.Now().Add(cl.cacheTTL(path, data))
+		}
+	}
+
+	return &cl.config.Images, nil
+}
+
+// ImageURL builds the full URL of an image path, see ImageConfiguration.URL
+func (cl *Client) ImageURL(ctx context.Context, path string, kind ImageKind, width int, policy SizePolicy) (string, error) {
+	config, err := cl.ImageConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return config.URL(path, kind, width, policy), nil
+}
+
+// ImageKind picks which of TMDB's size lists applies to an image
+type ImageKind string
+
+const (
+	PosterImage   ImageKind = "poster"
+	BackdropImage ImageKind = "backdrop"
+	LogoImage     ImageKind = "logo"
+	ProfileImage  ImageKind = "profile"
+	StillImage    ImageKind = "still"
+)
+
+// SizePolicy decides which available size is picked for a wanted width
+type SizePolicy int
+
+const (
+	// AtLeast picks the smallest size at least as wide as wanted, falling back to the original
+	AtLeast SizePolicy = iota
+	// AtMost picks the largest size no wider than wanted, falling back to the smallest
+	AtMost
+	// Closest picks the size nearest to the wanted width
+	Closest
+)
+
+// OriginalSize is the size name for an image at its uploaded resolution
+const OriginalSize = "original"
+
+func (c *ImageConfiguration) Sizes(kind ImageKind) []string {
+	switch kind {
+	case PosterImage:
+		return c.PosterSizes
+	case BackdropImage:
+		return c.BackdropSizes
+	case LogoImage:
+		return c.LogoSizes
+	case ProfileImage:
+		return c.ProfileSizes
+	case StillImage:
+		return c.StillSizes
+	}
+
+	return nil
+}
+
+// sizeWidth parses the pixel count out of a size name like w500 or h632,
+// returning false for original
+func sizeWidth(size string) (int, bool) {
+	if len(size) < 2 || (size[0] != 'w' && size[0] != 'h') {
+		return 0, false
+	}
+
+	px, err := strconv.Atoi(size[1:])
+	return px, err == nil
+}
+
+// NearestSize picks the size name of kind matching width under policy.
+// A width of 0 or less picks the original.
+func (c *ImageConfiguration) NearestSize(kind ImageKind, width int, policy SizePolicy) string {
+	if width <= 0 {
+		return OriginalSize
+	}
+
+	best, bestWidth := "", 0
+	smallest, smallestWidth := "", 0
+	for _, size := range c.Sizes(kind) {
+		px, ok := sizeWidth(size)
+		if !ok {
+			continue
+		}
+
+		if smallest == "" || px < smallestWidth {
+			smallest, smallestWidth = size, px
+		}
+
+		switch policy {
+		case AtLeast:
+			if px >= width && (best == "" || px < bestWidth) {
+				best, bestWidth = size, px
+			}
+		case AtMost:
+			if px <= width && (best == "" || px > bestWidth) {
+				best, bestWidth = size, px
+			}
+		case Closest:
+			if best == "" || num.Abs(px-width) < num.Abs(bestWidth-width) {
+				best, bestWidth = size, px
+			}
+		}
+	}
+
+	switch {
+	case best != "":
+		return best
+	case policy == AtMost && smallest != "":
+		return smallest
+	}
+
+	return OriginalSize
+}
+
+// URL builds the full URL of an image path at the size picked by NearestSize.
+// An empty path returns an empty URL.
+func (c *ImageConfiguration) URL(path string, kind ImageKind, width int, policy SizePolicy) string {
+	return c.SizedURL(path, c.NearestSize(kind, width, policy))
+}
+
+// SizedURL builds the full URL of an image path at a size name like w500 or original
+func (c *ImageConfiguration) SizedURL(path string, size string) string {
+	if path == "" {
+		return ""
+	}
+
+	base := non.Zero(c.SecureBaseURL, c.BaseURL)
+	return strings.TrimSuffix(base, "/") + "/" + size + "/" + strings.TrimPrefix(path, "/")
+}
@@ -0,0 +1,98 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var testImageConfig = ImageConfiguration{
+	SecureBaseURL: "https://image.tmdb.org/t/p/",
+	PosterSizes:   []string{"w92", "w154", "w185", "w342", "w500", "w780", "original"},
+	ProfileSizes:  []string{"w45", "w185", "h632", "original"},
+	LogoSizes:     []string{"original"},
+}
+
+func TestNearestSize(t *testing.T) {
+	tests := []struct {
+		kind   ImageKind
+		width  int
+		policy SizePolicy
+		want   string
+	}{
+		{PosterImage, 300, AtLeast, "w342"},
+		{PosterImage, 342, AtLeast, "w342"},
+		{PosterImage, 1000, AtLeast, "original"}, // Wider than any sized one
+		{PosterImage, 300, AtMost, "w185"},
+		{PosterImage, 342, AtMost, "w342"},
+		{PosterImage, 50, AtMost, "w92"}, // Narrower than any, so the smallest
+		{PosterImage, 300, Closest, "w342"},
+		{PosterImage, 170, Closest, "w185"},
+		{PosterImage, 5000, Closest, "w780"},
+		{PosterImage, 0, AtLeast, "original"},
+		{ProfileImage, 600, AtLeast, "h632"},
+		{LogoImage, 300, AtMost, "original"}, // Only the original is available
+		{StillImage, 300, Closest, "original"},
+	}
+
+	for _, tt := range tests {
+		if got := testImageConfig.NearestSize(tt.kind, tt.width, tt.policy); got != tt.want {
+			t.Errorf("NearestSize(%s, %d, %d) = %s, want %s", tt.kind, tt.width, tt.policy, got, tt.want)
+		}
+	}
+}
+
+func TestImageConfigURL(t *testing.T) {
+	if got, want := testImageConfig.URL("/abc.jpg", PosterImage, 300, AtLeast), "https://image.tmdb.org/t/p/w342/abc.jpg"; got != want {
+		t.Errorf("URL() = %s, want %s", got, want)
+	}
+
+	if got := testImageConfig.URL("", PosterImage, 300, AtLeast); got != "" {
+		t.Errorf("URL() of no path = %s, want none", got)
+	}
+}
+
+func TestImageConfigExpiry(t *testing.T) {
+	var requests, failing atomic.Int32
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		n := requests.Add(1)
+		if failing.Load() != 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		fmt.Fprintf(w, `{"images": {"secure_base_url": "https://image%d.example/"}}`, n)
+	}, WithRetryPolicy(NoRetry), WithCacheTTL(func(path string, data []byte) time.Duration {
+		return time.Hour
+	}))
+
+	for range 2 {
+		config, err := cl.ImageConfig(context.Background())
+		if err != nil || config.SecureBaseURL != "https://image1.example/" {
+			t.Fatalf("ImageConfig() = %v, %v, want the first response", config, err)
+		}
+	}
+
+	if requests.Load() != 1 {
+		t.Fatalf("made %d requests, want 1", requests.Load())
+	}
+
+	// Once expired it's fetched again, keeping the old one while TMDB is down
+	cl.configExpires = time.Now().Add(-time.Minute)
+	failing.Store(1)
+
+	config, err := cl.ImageConfig(context.Background())
+	if err != nil || config.SecureBaseURL != "https://image1.example/" {
+		t.Errorf("ImageConfig() while failing = %v, %v, want the old one", config, err)
+	}
+
+	failing.Store(0)
+
+	config, err = cl.ImageConfig(context.Background())
+	if err != nil || config.SecureBaseURL != "https://image3.example/" {
+		t.Errorf("ImageConfig() = %v, %v, want the refetched one", config, err)
+	}
+}
@@ -0,0 +1,231 @@
+package tmdb
+
+import (
+	"context"
+	"iter"
+	"strconv"
+	"strings"
+)
+
+// SortOrder orders discover results
+type SortOrder string
+
+const (
+	PopularityDesc     SortOrder = "popularity.desc"
+	PopularityAsc      SortOrder = "popularity.asc"
+	VoteAverageDesc    SortOrder = "vote_average.desc"
+	VoteAverageAsc     SortOrder = "vote_average.asc"
+	VoteCountDesc      SortOrder = "vote_count.desc"
+	VoteCountAsc       SortOrder = "vote_count.asc"
+	PrimaryReleaseDesc SortOrder = "primary_release_date.desc" // Movies only
+	PrimaryReleaseAsc  SortOrder = "primary_release_date.asc"  // Movies only
+	RevenueDesc        SortOrder = "revenue.desc"              // Movies only
+	TitleAsc           SortOrder = "title.asc"                 // Movies only
+	OriginalTitleAsc   SortOrder = "original_title.asc"        // Movies only
+	FirstAirDateDesc   SortOrder = "first_air_date.desc"       // Series only
+	FirstAirDateAsc    SortOrder = "first_air_date.asc"        // Series only
+	NameAsc            SortOrder = "name.asc"                  // Series only
+	OriginalNameAsc    SortOrder = "original_name.asc"         // Series only
+)
+
+// AllOf joins IDs for a discover filter that must match every one, eg. WithGenres
+func AllOf(ids ...int) string {
+	return joinIDs(ids, ",")
+}
+
+// AnyOf joins IDs for a discover filter that must match at least one, eg. WithGenres
+func AnyOf(ids ...int) string {
+	return joinIDs(ids, "|")
+}
+
+func joinIDs(ids []int, sep string) string {
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = strconv.Itoa(id)
+	}
+
+	return strings.Join(strs, sep)
+}
+
+// yearStart and yearEnd bound a year range as dates, zero years leave the bound open
+func yearStart(year int) string {
+	if year <= 0 {
+		return ""
+	}
+
+	return strconv.Itoa(year) + "-01-01"
+}
+
+func yearEnd(year int) string {
+	if year <= 0 {
+		return ""
+	}
+
+	return strconv.Itoa(year) + "-12-31"
+}
+
+type DiscoverMovieParams struct {
+	Language              string    `url:"language,omitempty"`
+	Region                string    `url:"region,omitempty"`
+	Page                  int32     `url:"page,omitempty"`
+	SortBy                SortOrder `url:"sort_by,omitempty"`
+	IncludeAdult          bool      `url:"include_adult,omitempty"`
+	IncludeVideo          bool      `url:"include_video,omitempty"`
+	Year                  int       `url:"year,omitempty"`
+	PrimaryReleaseYear    int       `url:"primary_release_year,omitempty"`
+	PrimaryReleaseDateGTE string    `url:"primary_release_date.gte,omitempty"`
+	PrimaryReleaseDateLTE string    `url:"primary_release_date.lte,omitempty"`
+	ReleaseDateGTE        string    `url:"release_date.gte,omitempty"`
+	ReleaseDateLTE        string    `url:"release_date.lte,omitempty"`
+	WithReleaseType       string    `url:"with_release_type,omitempty"` // See AllOf and AnyOf
+	Certification         string    `url:"certification,omitempty"`
+	CertificationCountry  string    `url:"certification_country,omitempty"`
+	WithGenres            string    `url:"with_genres,omitempty"`    // See AllOf and AnyOf
+	WithoutGenres         string    `url:"without_genres,omitempty"` // See AllOf and AnyOf
+	WithKeywords          string    `url:"with_keywords,omitempty"`  // See AllOf and AnyOf
+	WithCompanies         string    `url:"with_companies,omitempty"` // See AllOf and AnyOf
+	WithCast              string    `url:"with_cast,omitempty"`      // See AllOf and AnyOf
+	WithCrew              string    `url:"with_crew,omitempty"`      // See AllOf and AnyOf
+	WithOriginalLanguage  string    `url:"with_original_language,omitempty"`
+	WithOriginCountry     string    `url:"with_origin_country,omitempty"`
+	WithRuntimeGTE        int       `url:"with_runtime.gte,omitempty"`
+	WithRuntimeLTE        int       `url:"with_runtime.lte,omitempty"`
+	VoteAverageGTE        float64   `url:"vote_average.gte,omitempty"`
+	VoteCountGTE          int       `url:"vote_count.gte,omitempty"`
+}
+
+// Years limits results to movies first released between from and to inclusive,
+// a zero year leaves that end open
+func (p *DiscoverMovieParams) Years(from int, to int) {
+	p.PrimaryReleaseDateGTE = yearStart(from)
+	p.PrimaryReleaseDateLTE = yearEnd(to)
+}
+
+type DiscoverTVParams struct {
+	Language                 string    `url:"language,omitempty"`
+	Page                     int32     `url:"page,omitempty"`
+	SortBy                   SortOrder `url:"sort_by,omitempty"`
+	IncludeAdult             bool      `url:"include_adult,omitempty"`
+	IncludeNullFirstAirDates bool      `url:"include_null_first_air_dates,omitempty"`
+	Timezone                 string    `url:"timezone,omitempty"`
+	FirstAirDateYear         int       `url:"first_air_date_year,omitempty"`
+	FirstAirDateGTE          string    `url:"first_air_date.gte,omitempty"`
+	FirstAirDateLTE          string    `url:"first_air_date.lte,omitempty"`
+	AirDateGTE               string    `url:"air_date.gte,omitempty"`
+	AirDateLTE               string    `url:"air_date.lte,omitempty"`
+	WithGenres               string    `url:"with_genres,omitempty"`    // See AllOf and AnyOf
+	WithoutGenres            string    `url:"without_genres,omitempty"` // See AllOf and AnyOf
+	WithKeywords             string    `url:"with_keywords,omitempty"`  // See AllOf and AnyOf
+	WithCompanies            string    `url:"with_companies,omitempty"` // See AllOf and AnyOf
+	WithNetworks             int       `url:"with_networks,omitempty"`
+	WithOriginalLanguage     string    `url:"with_original_language,omitempty"`
+	WithOriginCountry        string    `url:"with_origin_country,omitempty"`
+	WithRuntimeGTE           int       `url:"with_runtime.gte,omitempty"`
+	WithRuntimeLTE           int       `url:"with_runtime.lte,omitempty"`
+	WithStatus               string    `url:"with_status,omitempty"` // See AnyOf, 0 Returning, 1 Planned, 2 In Production, 3 Ended, 4 Canceled, 5 Pilot
+	WithType                 string    `url:"with_type,omitempty"`   // See AnyOf, 0 Documentary, 1 News, 2 Miniseries, 3 Reality, 4 Scripted, 5 Talk Show, 6 Video
+	ScreenedTheatrically     bool      `url:"screened_theatrically,omitempty"`
+	VoteAverageGTE           float64   `url:"vote_average.gte,omitempty"`
+	VoteCountGTE             int       `url:"vote_count.gte,omitempty"`
+}
+
+// Years limits results to series first aired between from and to inclusive,
+// a zero year leaves that end open
+func (p *DiscoverTVParams) Years(from int, to int) {
+	p.FirstAirDateGTE = yearStart(from)
+	p.FirstAirDateLTE = yearEnd(to)
+}
+
+func (cl *Client) DiscoverMovie(params DiscoverMovieParams) (*SearchResponse[Movie], error) {
+	return cl.DiscoverMovieContext(context.Background(), params)
+}
+
+// https://developer.themoviedb.org/reference/discover-movie
+// https://api.themoviedb.org/3/discover/movie
+func (cl *Client) DiscoverMovieContext(ctx context.Context, params DiscoverMovieParams) (*SearchResponse[Movie], error) {
+	path := "discover/movie"
+	return getJSON[SearchResponse[Movie]](ctx, cl, path, params)
+}
+
+func (cl *Client) DiscoverTV(params DiscoverTVParams) (*SearchResponse[TVShow], error) {
+	return cl.DiscoverTVContext(context.Background(), params)
+}
+
+// https://developer.themoviedb.org/reference/discover-tv
+// https://api.themoviedb.org/3/discover/tv
+func (cl *Client) DiscoverTVContext(ctx context.Context, params DiscoverTVParams) (*SearchResponse[TVShow], error) {
+	path := "discover/tv"
+	return getJSON[SearchResponse[TVShow]](ctx, cl, path, params)
+}
+
+// DiscoverMoviePages iterates over the pages of discovered movies, starting at params.Page
+func (cl *Client) DiscoverMoviePages(ctx context.Context, params DiscoverMovieParams) iter.Seq2[*SearchResponse[Movie], error] {
+	return searchPages(ctx, params.Page, func(ctx context.Context, page int32) (*SearchResponse[Movie], error) {
+		params.Page = page
+		return cl.DiscoverMovieContext(ctx, params)
+	})
+}
+
+// DiscoverTVPages iterates over the pages of discovered series, starting at params.Page
+func (cl *Client) DiscoverTVPages(ctx context.Context, params DiscoverTVParams) iter.Seq2[*SearchResponse[TVShow], error] {
+	return searchPages(ctx, params.Page, func(ctx context.Context, page int32) (*SearchResponse[TVShow], error) {
+		params.Page = page
+		return cl.DiscoverTVContext(ctx, params)
+	})
+}
+
+// TrendingMediaType picks what is trending
+type TrendingMediaType string
+
+const (
+	TrendingAll    TrendingMediaType = "all"
+	TrendingMovie  TrendingMediaType = "movie"
+	TrendingTV     TrendingMediaType = "tv"
+	TrendingPerson TrendingMediaType = "person"
+)
+
+// TimeWindow is the period trending is measured over
+type TimeWindow string
+
+const (
+	Day  TimeWindow = "day"
+	Week TimeWindow = "week"
+)
+
+type TrendingParams struct {
+	Language string `url:"language,omitempty"`
+	Page     int32  `url:"page,omitempty"`
+}
+
+func (cl *Client) Trending(mediaType TrendingMediaType, window TimeWindow, params TrendingParams) (*SearchResponse[MultiMedia], error) {
+	return cl.TrendingContext(context.Background(), mediaType, window, params)
+}
+
+// https://developer.themoviedb.org/reference/trending-all
+// https://api.themoviedb.org/3/trending/{media_type}/{time_window}
+func (cl *Client) TrendingContext(ctx context.Context, mediaType TrendingMediaType, window TimeWindow, params TrendingParams) (*SearchResponse[MultiMedia], error) {
+	path := "trending/" + string(mediaType) + "/" + string(window)
+	return getJSON[SearchResponse[MultiMedia]](ctx, cl, path, params)
+}
+
+func (cl *Client) TrendingMovies(window TimeWindow, params TrendingParams) (*SearchResponse[Movie], error) {
+	return cl.TrendingMoviesContext(context.Background(), window, params)
+}
+
+// https://developer.themoviedb.org/reference/trending-movies
+// https://api.themoviedb.org/3/trending/movie/{time_window}
+func (cl *Client) TrendingMoviesContext(ctx context.Context, window TimeWindow, params TrendingParams) (*SearchResponse[Movie], error) {
+	path := "trending/movie/" + string(window)
+	return getJSON[SearchResponse[Movie]](ctx, cl, path, params)
+}
+
+func (cl *Client) TrendingTV(window TimeWindow, params TrendingParams) (*SearchResponse[TVShow], error) {
+	return cl.TrendingTVContext(context.Background(), window, params)
+}
+
+// https://developer.themoviedb.org/reference/trending-tv
+// https://api.themoviedb.org/3/trending/tv/{time_window}
+func (cl *Client) TrendingTVContext(ctx context.Context, window TimeWindow, params TrendingParams) (*SearchResponse[TVShow], error) {
+	path := "trending/tv/" + string(window)
+	return getJSON[SearchResponse[TVShow]](ctx, cl, path, params)
+}
@@ -0,0 +1,59 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+var ErrEpisodeNotFound = errors.New("tmdb: episode not found")
+
+func (cl *Client) TVEpisode(seriesId string, seasonNum int, episodeNum int, params DetailsParams) (*EpisodeDetails, error) {
+	return cl.TVEpisodeContext(context.Background(), seriesId, seasonNum, episodeNum, params)
+}
+
+// https://developer.themoviedb.org/reference/tv-episode-details
+// https://api.themoviedb.org/3/tv/{series_id}/season/{season_number}/episode/{episode_number}
+func (cl *Client) TVEpisodeContext(ctx context.Context, seriesId string, seasonNum int, episodeNum int, params DetailsParams) (*EpisodeDetails, error) {
+	if len(splitAppend(params.AppendToResponse)) > MaxAppend {
+		return nil, ErrTooManyAppends
+	}
+
+	path := "tv/" + seriesId + "/season/" + strconv.Itoa(seasonNum) + "/episode/" + strconv.Itoa(episodeNum)
+	return getJSON[EpisodeDetails](ctx, cl, path, params)
+}
+
+// Convenience methods for simple calls without parameters
+func (cl *Client) TVEpisodeByID(seriesId string, seasonNum int, episodeNum int) (*EpisodeDetails, error) {
+	return cl.TVEpisodeByIDContext(context.Background(), seriesId, seasonNum, episodeNum)
+}
+
+func (cl *Client) TVEpisodeByIDContext(ctx context.Context, seriesId string, seasonNum int, episodeNum int) (*EpisodeDetails, error) {
+	return cl.TVEpisodeContext(ctx, seriesId, seasonNum, episodeNum, DetailsParams{})
+}
+
+func (cl *Client) TVEpisodes(seriesId string, seasonNum int, episodeNums []int, params DetailsParams) ([]Episode, error) {
+	return cl.TVEpisodesContext(context.Background(), seriesId, seasonNum, episodeNums, params)
+}
+
+// TVEpisodesContext fetches several episodes of a season in one request, in the order given,
+// eg. for a multi episode file like S01E01-E03. Any episode missing from the season fails with ErrEpisodeNotFound.
+func (cl *Client) TVEpisodesContext(ctx context.Context, seriesId string, seasonNum int, episodeNums []int, params DetailsParams) ([]Episode, error) {
+	season, err := cl.TVSeasonContext(ctx, seriesId, seasonNum, params)
+	if err != nil {
+		return nil, err
+	}
+
+	episodes := make([]Episode, 0, len(episodeNums))
+	for _, num := range episodeNums {
+		episode := season.Episode(num)
+		if episode == nil {
+			return nil, fmt.Errorf("%w: season %d episode %d", ErrEpisodeNotFound, seasonNum, num)
+		}
+
+		episodes = append(episodes, *episode)
+	}
+
+	return episodes, nil
+}
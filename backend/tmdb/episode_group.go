@@ -1,37 +1,100 @@
 package tmdb
 
 import (
-	"encoding/json"
-	"fmt"
-	"io"
-	"net/http"
-	"net/url"
+	"context"
+	"slices"
 )
 
+func (cl *Client) EpisodesGroupedBy(groupId string) (*EpisodeGroupDetails, error) {
+	return cl.EpisodesGroupedByContext(context.Background(), groupId)
+}
+
 // https://developer.themoviedb.org/reference/tv-episode-group-details
 // https://api.themoviedb.org/3/tv/episode_group/{tv_episode_group_id}
-func (cl *Client) EpisodesGroupedBy(groupId string) (*EpisodeGroupDetails, error) {
+func (cl *Client) EpisodesGroupedByContext(ctx context.Context, groupId string) (*EpisodeGroupDetails, error) {
 	path := "tv/episode_group/" + groupId
+	return getJSON[EpisodeGroupDetails](ctx, cl, path, nil)
+}
+
+// Episodes returns the group's episodes in order, group by group, eg. for absolute numbering.
+// Specials are left out, like season 0 is from a series' own numbering.
+func (d *EpisodeGroupDetails) Episodes() []Episode {
+	var episodes []Episode
+	for _, group := range d.ordered() {
+		if group.specials() {
+			continue
+		}
 
-	resp, err := cl.get(path, url.Values{})
-	if err != nil {
-		return nil, err
+		ordered := slices.Clone(group.Episodes)
+		slices.SortStableFunc(ordered, func(a, b Episode) int {
+			return a.Order - b.Order
+		})
+
+		episodes = append(episodes, ordered...)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
+	return episodes
+}
+
+// Season returns the group standing in for season num in the ordering, counting from 1 and skipping specials,
+// which are season 0. Returns nil if there's no such season.
+func (d *EpisodeGroupDetails) Season(num int) *EpisodeGroupSeason {
+	var seasons []EpisodeGroupSeason
+	for _, group := range d.ordered() {
+		if group.specials() {
+			if num == 0 {
+				return &group
+			}
+
+			continue
+		}
+
+		seasons = append(seasons, group)
 	}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
+	if num < 1 || num > len(seasons) {
+		return nil
 	}
 
-	var groupDetails EpisodeGroupDetails
-	if err := json.Unmarshal(body, &groupDetails); err != nil {
-		return nil, err
+	return &seasons[num-1]
+}
+
+// ordered returns the groups sorted by their position in the ordering
+func (d *EpisodeGroupDetails) ordered() []EpisodeGroupSeason {
+	groups := slices.Clone(d.Groups)
+	slices.SortStableFunc(groups, func(a, b EpisodeGroupSeason) int {
+		return a.Order - b.Order
+	})
+
+	return groups
+}
+
+// specials reports whether the group holds specials rather than a season, ie. all its episodes are from TMDB's season 0.
+// TMDB has no flag for it and the group names are free text, often in another language.
+func (s *EpisodeGroupSeason) specials() bool {
+	if len(s.Episodes) == 0 {
+		return false
+	}
+
+	for _, episode := range s.Episodes {
+		if episode.SeasonNumber != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Episode returns the episode numbered num within the group, counting from 1, or nil if there's no such episode
+func (s *EpisodeGroupSeason) Episode(num int) *Episode {
+	episodes := slices.Clone(s.Episodes)
+	slices.SortStableFunc(episodes, func(a, b Episode) int {
+		return a.Order - b.Order
+	})
+
+	if num < 1 || num > len(episodes) {
+		return nil
 	}
 
-	return &groupDetails, nil
+	return &episodes[num-1]
 }
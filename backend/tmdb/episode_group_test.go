@@ -0,0 +1,42 @@
+package tmdb
+
+import "testing"
+
+func TestEpisodeGroupSkipsSpecials(t *testing.T) {
+	// As TMDB lists some absolute groups, with specials first. Group names are free text,
+	// so specials are told apart by their episodes being from season 0.
+	group := EpisodeGroupDetails{Type: AbsoluteGrouping, Groups: []EpisodeGroupSeason{
+		{Name: "Episodes 3-4", Order: 2, Episodes: []Episode{
+			{ID: 4, SeasonNumber: 1, Order: 1}, {ID: 3, SeasonNumber: 1, Order: 0},
+		}},
+		{Name: "特別編", Order: 0, Episodes: []Episode{
+			{ID: 100, SeasonNumber: 0, Order: 0},
+		}},
+		{Name: "Special Forces Arc", Order: 1, Episodes: []Episode{
+			{ID: 1, SeasonNumber: 1, Order: 0}, {ID: 2, SeasonNumber: 1, Order: 1},
+		}},
+	}}
+
+	episodes := group.Episodes()
+	if len(episodes) != 4 {
+		t.Fatalf("Episodes() returned %d episodes, want 4", len(episodes))
+	}
+
+	for i, episode := range episodes {
+		if episode.ID != i+1 {
+			t.Errorf("Episodes()[%d] = %d, want %d", i, episode.ID, i+1)
+		}
+	}
+
+	if season := group.Season(1); season == nil || season.Name != "Special Forces Arc" {
+		t.Errorf("Season(1) = %v, want Special Forces Arc", season)
+	}
+
+	if season := group.Season(0); season == nil || season.Name != "特別編" {
+		t.Errorf("Season(0) = %v, want the specials", season)
+	}
+
+	if season := group.Season(3); season != nil {
+		t.Errorf("Season(3) = %v, want nil", season)
+	}
+}
@@ -0,0 +1,98 @@
+package tmdb
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mediajerk/backend/non"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// APIError is returned when TMDB responds with a non 2xx status
+type APIError struct {
+	StatusCode int           // HTTP status of the response
+	Code       int           // TMDB status_code, see https://developer.themoviedb.org/docs/errors
+	Message    string        // TMDB status_message
+	Path       string        // Request path, without the base URL or query
+	RetryAfter time.Duration // Parsed Retry-After header, zero if absent
+}
+
+func (e *APIError) Error() string {
+	msg := non.Zero(e.Message, http.StatusText(e.StatusCode))
+	if e.Code != 0 {
+		return fmt.Sprintf("tmdb: %s: %d %s (code %d)", e.Path, e.StatusCode, msg, e.Code)
+	}
+
+	return fmt.Sprintf("tmdb: %s: %d %s", e.Path, e.StatusCode, msg)
+}
+
+// newAPIError builds an APIError from a failed response, consuming its body
+func newAPIError(resp *http.Response, path string) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Path:       path,
+	}
+
+	// TMDB error bodies are small, anything bigger isn't one of theirs
+	var body struct {
+		StatusCode    int    `json:"status_code"`
+		StatusMessage string `json:"status_message"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&body); err == nil {
+		apiErr.Code = body.StatusCode
+		apiErr.Message = body.StatusMessage
+	}
+
+	apiErr.RetryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+
+	return apiErr
+}
+
+// parseRetryAfter parses a Retry-After header value,
+// which is either a number of seconds or an HTTP date
+func parseRetryAfter(value string) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+
+	if secs, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(secs)*time.Second, 0)
+	}
+
+	if at, err := http.ParseTime(value); err == nil {
+		return max(time.Until(at), 0)
+	}
+
+	return 0
+}
+
+func hasStatus(err error, status int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == status
+}
+
+// IsNotFound reports whether err is a TMDB 404, eg. an unknown movie or series ID
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsUnauthorized reports whether err is a TMDB 401, usually an invalid or missing API key
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsRateLimited reports whether err is a TMDB 429
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+// IsServerError reports whether err is a TMDB 5xx
+func IsServerError(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode >= 500
+}
@@ -0,0 +1,75 @@
+package tmdb
+
+import (
+	"context"
+	"strconv"
+)
+
+// ExternalSource is the site an external ID passed to Find belongs to
+type ExternalSource string
+
+const (
+	IMDbSource      ExternalSource = "imdb_id"
+	TVDBSource      ExternalSource = "tvdb_id"
+	WikidataSource  ExternalSource = "wikidata_id"
+	FacebookSource  ExternalSource = "facebook_id"
+	InstagramSource ExternalSource = "instagram_id"
+	TwitterSource   ExternalSource = "twitter_id"
+	TikTokSource    ExternalSource = "tiktok_id"
+	YouTubeSource   ExternalSource = "youtube_id"
+)
+
+type FindParams struct {
+	ExternalSource ExternalSource `url:"external_source"`
+	Language       string         `url:"language,omitempty"`
+}
+
+// SeasonResult is a season found by an external ID, with the ID of its series
+type SeasonResult struct {
+	Season
+	ShowID int `json:"show_id"`
+}
+
+// FindResponse groups what an external ID matched by media type,
+// usually only one of the lists has a result
+type FindResponse struct {
+	MovieResults     []Movie        `json:"movie_results"`
+	TVResults        []TVShow       `json:"tv_results"`
+	TVSeasonResults  []SeasonResult `json:"tv_season_results"`
+	TVEpisodeResults []Episode      `json:"tv_episode_results"`
+	PersonResults    []Person       `json:"person_results"`
+}
+
+// Empty reports whether nothing matched
+func (f *FindResponse) Empty() bool {
+	return len(f.MovieResults) == 0 && len(f.TVResults) == 0 && len(f.TVSeasonResults) == 0 &&
+		len(f.TVEpisodeResults) == 0 && len(f.PersonResults) == 0
+}
+
+func (cl *Client) Find(externalId string, params FindParams) (*FindResponse, error) {
+	return cl.FindContext(context.Background(), externalId, params)
+}
+
+// https://developer.themoviedb.org/reference/find-by-id
+// https://api.themoviedb.org/3/find/{external_id}
+func (cl *Client) FindContext(ctx context.Context, externalId string, params FindParams) (*FindResponse, error) {
+	path := "find/" + externalId
+	return getJSON[FindResponse](ctx, cl, path, params)
+}
+
+// Convenience methods for the most common external sources
+func (cl *Client) FindByIMDbID(imdbId string) (*FindResponse, error) {
+	return cl.FindByIMDbIDContext(context.Background(), imdbId)
+}
+
+func (cl *Client) FindByIMDbIDContext(ctx context.Context, imdbId string) (*FindResponse, error) {
+	return cl.FindContext(ctx, imdbId, FindParams{ExternalSource: IMDbSource})
+}
+
+func (cl *Client) FindByTVDBID(tvdbId int) (*FindResponse, error) {
+	return cl.FindByTVDBIDContext(context.Background(), tvdbId)
+}
+
+func (cl *Client) FindByTVDBIDContext(ctx context.Context, tvdbId int) (*FindResponse, error) {
+	return cl.FindContext(ctx, strconv.Itoa(tvdbId), FindParams{ExternalSource: TVDBSource})
+}
@@ -0,0 +1,132 @@
+package tmdb
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type Keyword struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type KeywordsResponse struct {
+	Keywords []Keyword `json:"keywords"`
+}
+
+func (k *KeywordsResponse) UnmarshalJSON(data []byte) error {
+	// Movies list their keywords under keywords, series under results
+	var raw struct {
+		Keywords []Keyword `json:"keywords"`
+		Results  []Keyword `json:"results"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	k.Keywords = append(raw.Keywords, raw.Results...)
+	return nil
+}
+
+// Names returns the keyword names, eg. for tagging
+func (k *KeywordsResponse) Names() []string {
+	names := make([]string, len(k.Keywords))
+	for i, keyword := range k.Keywords {
+		names[i] = keyword.Name
+	}
+
+	return names
+}
+
+type WatchProvider struct {
+	DisplayPriority int     `json:"display_priority"`
+	LogoPath        *string `json:"logo_path"`
+	ProviderID      int     `json:"provider_id"`
+	ProviderName    string  `json:"provider_name"`
+}
+
+// CountryWatchProviders lists where a title can be watched in one country, by how it's offered
+type CountryWatchProviders struct {
+	Link     string          `json:"link"` // TMDB's watch page, required attribution for JustWatch data
+	Flatrate []WatchProvider `json:"flatrate"`
+	Free     []WatchProvider `json:"free"`
+	Ads      []WatchProvider `json:"ads"`
+	Rent     []WatchProvider `json:"rent"`
+	Buy      []WatchProvider `json:"buy"`
+}
+
+// WatchProvidersResponse maps ISO 3166-1 country codes to their providers
+type WatchProvidersResponse struct {
+	Results map[string]CountryWatchProviders `json:"results"`
+}
+
+// Country returns the providers in country, eg. US, or nil if there are none
+func (w *WatchProvidersResponse) Country(country string) *CountryWatchProviders {
+	providers, ok := w.Results[country]
+	if !ok {
+		return nil
+	}
+
+	return &providers
+}
+
+// Streaming returns the names of the subscription, free and ad supported providers in country
+func (w *WatchProvidersResponse) Streaming(country string) []string {
+	providers := w.Country(country)
+	if providers == nil {
+		return nil
+	}
+
+	var names []string
+	for _, list := range [][]WatchProvider{providers.Flatrate, providers.Free, providers.Ads} {
+		for _, provider := range list {
+			names = append(names, provider.ProviderName)
+		}
+	}
+
+	return uniqueTitles(names...)
+}
+
+func (cl *Client) MovieKeywords(movieId string) (*KeywordsResponse, error) {
+	return cl.MovieKeywordsContext(context.Background(), movieId)
+}
+
+// https://developer.themoviedb.org/reference/movie-keywords
+// https://api.themoviedb.org/3/movie/{movie_id}/keywords
+func (cl *Client) MovieKeywordsContext(ctx context.Context, movieId string) (*KeywordsResponse, error) {
+	path := "movie/" + movieId + "/keywords"
+	return getJSON[KeywordsResponse](ctx, cl, path, nil)
+}
+
+func (cl *Client) TVSeriesKeywords(seriesId string) (*KeywordsResponse, error) {
+	return cl.TVSeriesKeywordsContext(context.Background(), seriesId)
+}
+
+// https://developer.themoviedb.org/reference/tv-series-keywords
+// https://api.themoviedb.org/3/tv/{series_id}/keywords
+func (cl *Client) TVSeriesKeywordsContext(ctx context.Context, seriesId string) (*KeywordsResponse, error) {
+	path := "tv/" + seriesId + "/keywords"
+	return getJSON[KeywordsResponse](ctx, cl, path, nil)
+}
+
+func (cl *Client) MovieWatchProviders(movieId string) (*WatchProvidersResponse, error) {
+	return cl.MovieWatchProvidersContext(context.Background(), movieId)
+}
+
+// https://developer.themoviedb.org/reference/movie-watch-providers
+// https://api.themoviedb.org/3/movie/{movie_id}/watch/providers
+func (cl *Client) MovieWatchProvidersContext(ctx context.Context, movieId string) (*WatchProvidersResponse, error) {
+	path := "movie/" + movieId + "/watch/providers"
+	return getJSON[WatchProvidersResponse](ctx, cl, path, nil)
+}
+
+func (cl *Client) TVSeriesWatchProviders(seriesId string) (*WatchProvidersResponse, error) {
+	return cl.TVSeriesWatchProvidersContext(context.Background(), seriesId)
+}
+
+// https://developer.themoviedb.org/reference/tv-series-watch-providers
+// https://api.themoviedb.org/3/tv/{series_id}/watch/providers
+func (cl *Client) TVSeriesWatchProvidersContext(ctx context.Context, seriesId string) (*WatchProvidersResponse, error) {
+	path := "tv/" + seriesId + "/watch/providers"
+	return getJSON[WatchProvidersResponse](ctx, cl, path, nil)
+}
@@ -1,47 +1,38 @@
 package tmdb
 
-import (
-	"encoding/json"
-	"fmt"
-	"io"
-	"net/http"
+import "context"
 
-	"github.com/google/go-querystring/query"
-)
+func (cl *Client) Movies(movieId string, params DetailsParams) (*MovieDetails, error) {
+	return cl.MoviesContext(context.Background(), movieId, params)
+}
 
 // https://developer.themoviedb.org/reference/movie-details
 // https://api.themoviedb.org/3/movie/{movie_id}
-func (cl *Client) Movies(movieId string, params DetailsParams) (*MovieDetails, error) {
-	queryParams, err := query.Values(params)
-	if err != nil {
-		return nil, err
+func (cl *Client) MoviesContext(ctx context.Context, movieId string, params DetailsParams) (*MovieDetails, error) {
+	if len(splitAppend(params.AppendToResponse)) > MaxAppend {
+		return nil, ErrTooManyAppends
 	}
-	path := "movie/" + movieId
 
-	resp, err := cl.get(path, queryParams)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
-	}
+	path := "movie/" + movieId
+	return getJSON[MovieDetails](ctx, cl, path, params)
+}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
+// Convenience methods for simple calls without parameters
+func (cl *Client) MovieByID(movieId string) (*MovieDetails, error) {
+	return cl.MovieByIDContext(context.Background(), movieId)
+}
 
-	var movieDetails MovieDetails
-	if err := json.Unmarshal(body, &movieDetails); err != nil {
-		return nil, err
-	}
+func (cl *Client) MovieByIDContext(ctx context.Context, movieId string) (*MovieDetails, error) {
+	return cl.MoviesContext(ctx, movieId, DetailsParams{})
+}
 
-	return &movieDetails, nil
+func (cl *Client) MovieExternalIDs(movieId string) (*ExternalIDsResponse, error) {
+	return cl.MovieExternalIDsContext(context.Background(), movieId)
 }
 
-// Convenience methods for simple calls without parameters
-func (cl *Client) MovieByID(movieId string) (*MovieDetails, error) {
-	return cl.Movies(movieId, DetailsParams{})
+// https://developer.themoviedb.org/reference/movie-external-ids
+// https://api.themoviedb.org/3/movie/{movie_id}/external_ids
+func (cl *Client) MovieExternalIDsContext(ctx context.Context, movieId string) (*ExternalIDsResponse, error) {
+	path := "movie/" + movieId + "/external_ids"
+	return getJSON[ExternalIDsResponse](ctx, cl, path, nil)
 }
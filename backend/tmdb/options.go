@@ -0,0 +1,124 @@
+package tmdb
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Option configures a Client
+type Option func(*Client)
+
+// AuthMode selects how the client authenticates with TMDB
+type AuthMode int
+
+const (
+	// BearerAuth sends a v4 API read access token in the Authorization header
+	BearerAuth AuthMode = iota
+	// APIKeyAuth sends a v3 API key as the api_key query parameter
+	APIKeyAuth
+)
+
+// WithBaseURL points the client at a different API root, eg. a local stand-in for tests
+func WithBaseURL(baseURL string) Option {
+	return func(cl *Client) {
+		cl.baseURL = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
+// WithHTTPClient makes requests with client,
+// eg. to share connection pooling with the rest of an application
+func WithHTTPClient(client *http.Client) Option {
+	return func(cl *Client) {
+		if client != nil {
+			cl.http = client
+		}
+	}
+}
+
+// WithTransport makes requests through transport, eg. a proxying or instrumented round tripper
+func WithTransport(transport http.RoundTripper) Option {
+	return func(cl *Client) {
+		client := *cl.http
+		client.Transport = transport
+		cl.http = &client
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(cl *Client) {
+		cl.userAgent = userAgent
+	}
+}
+
+// WithAuthMode selects between a v4 read access token and a v3 API key, defaults to BearerAuth
+func WithAuthMode(mode AuthMode) Option {
+	return func(cl *Client) {
+		cl.authMode = mode
+	}
+}
+
+// WithRateLimit limits the client to perSecond requests, allowing bursts of up to burst requests.
+// A perSecond of 0 or less disables client-side rate limiting.
+func WithRateLimit(perSecond float64, burst int) Option {
+	return func(cl *Client) {
+		if perSecond <= 0 {
+			cl.limiter = nil
+			return
+		}
+
+		cl.limiter = newRateLimiter(perSecond, burst)
+	}
+}
+
+// WithRetryPolicy sets how failed requests are retried, use NoRetry to disable retrying
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(cl *Client) {
+		cl.retry = policy
+	}
+}
+
+// WithCache caches successful responses, see DiskCache
+func WithCache(cache Cache) Option {
+	return func(cl *Client) {
+		cl.cache = cache
+	}
+}
+
+// WithCacheTTL overrides DefaultTTL for deciding how long responses are cached
+func WithCacheTTL(ttl TTLFunc) Option {
+	return func(cl *Client) {
+		cl.cacheTTL = ttl
+	}
+}
+
+// WithOffline starts the client in offline mode, see SetOffline
+func WithOffline(offline bool) Option {
+	return func(cl *Client) {
+		cl.offline.Store(offline)
+	}
+}
+
+// RequestHook is called before every request is sent, including retries.
+// Requests served from the cache aren't sent and don't trigger hooks.
+// The request carries the API credentials, take care not to log them.
+type RequestHook func(req *http.Request)
+
+// ResponseHook is called after every request with its response or error and how long it took,
+// before the response status is checked. It must not read or close the response body.
+type ResponseHook func(req *http.Request, res *http.Response, err error, elapsed time.Duration)
+
+// WithRequestHook adds a hook called before each request, eg. for logging
+func WithRequestHook(hook RequestHook) Option {
+	return func(cl *Client) {
+		cl.onRequest = append(cl.onRequest, hook)
+	}
+}
+
+// WithResponseHook adds a hook called after each request, eg. for metrics
+func WithResponseHook(hook ResponseHook) Option {
+	return func(cl *Client) {
+		cl.onResponse = append(cl.onResponse, hook)
+	}
+}
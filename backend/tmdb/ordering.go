@@ -0,0 +1,126 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Grouping is an episode ordering, either the series' own seasons or one of its episode group types
+type Grouping int
+
+var ErrNoEpisodeGroup = errors.New("tmdb: series has no episode group of that type")
+
+var groupingNames = map[Grouping]string{
+	DefaultGrouping:    "default",
+	AirDateGrouping:    "aired",
+	AbsoluteGrouping:   "absolute",
+	DVDGrouping:        "dvd",
+	DigitalGrouping:    "digital",
+	StoryArcGrouping:   "storyarc",
+	ProductionGrouping: "production",
+	TVGrouping:         "tv",
+}
+
+func (g Grouping) String() string {
+	if name, ok := groupingNames[g]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("Grouping(%d)", int(g))
+}
+
+// ParseGrouping reads a name given by Grouping.String, eg. dvd
+func ParseGrouping(name string) (Grouping, error) {
+	for g, n := range groupingNames {
+		if strings.EqualFold(n, name) {
+			return g, nil
+		}
+	}
+
+	return DefaultGrouping, fmt.Errorf("tmdb: unknown episode ordering %q", name)
+}
+
+// OrderingResolver finds episodes by their season and episode numbers in an ordering other than TMDB's seasons,
+// eg. the DVD order of Firefly. The series' episode group of that type is fetched once and kept for reuse.
+type OrderingResolver struct {
+	mu       sync.Mutex
+	cache    seriesCache
+	grouping Grouping
+}
+
+func (cl *Client) NewOrderingResolver(seriesId string, grouping Grouping) *OrderingResolver {
+	return &OrderingResolver{cache: newSeriesCache(cl, seriesId), grouping: grouping}
+}
+
+// Grouping returns the ordering the resolver numbers episodes in
+func (r *OrderingResolver) Grouping() Grouping {
+	return r.grouping
+}
+
+func (r *OrderingResolver) Resolve(seasonNum int, episodeNum int) (*Episode, error) {
+	return r.ResolveContext(context.Background(), seasonNum, episodeNum)
+}
+
+// ResolveContext returns the episode numbered seasonNum and episodeNum in the ordering,
+// its own SeasonNumber and EpisodeNumber are TMDB's. A series without an episode group
+// of the ordering's type fails with ErrNoEpisodeGroup, a missing episode with ErrEpisodeNotFound.
+func (r *OrderingResolver) ResolveContext(ctx context.Context, seasonNum int, episodeNum int) (*Episode, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.grouping == DefaultGrouping {
+		season, err := r.cache.season(ctx, seasonNum)
+		if err != nil {
+			return nil, err
+		}
+
+		if episode := season.Episode(episodeNum); episode != nil {
+			found := *episode
+			return &found, nil
+		}
+	} else {
+		group, err := r.cache.group(ctx, r.grouping)
+		if err != nil {
+			return nil, err
+		}
+
+		if group == nil {
+			return nil, fmt.Errorf("%w: %s", ErrNoEpisodeGroup, r.grouping)
+		}
+
+		if season := group.Season(seasonNum); season != nil {
+			if episode := season.Episode(episodeNum); episode != nil {
+				return episode, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s season %d episode %d", ErrEpisodeNotFound, r.grouping, seasonNum, episodeNum)
+}
+
+func (cl *Client) Orderings(seriesId string) ([]Grouping, error) {
+	return cl.OrderingsContext(context.Background(), seriesId)
+}
+
+// OrderingsContext lists the orderings a series can be matched in, DefaultGrouping first,
+// then the types of its episode groups
+func (cl *Client) OrderingsContext(ctx context.Context, seriesId string) ([]Grouping, error) {
+	list, err := cl.EpisodeGroupsContext(ctx, seriesId)
+	if err != nil {
+		return nil, err
+	}
+
+	orderings := []Grouping{DefaultGrouping}
+	for _, group := range list.Results {
+		if !slices.Contains(orderings, group.Type) {
+			orderings = append(orderings, group.Type)
+		}
+	}
+
+	slices.Sort(orderings)
+	return orderings, nil
+}
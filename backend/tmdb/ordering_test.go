@@ -0,0 +1,127 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+// fixtures serves a canned body per request path, counting the requests to each, and 404s anything else
+func fixtures(t *testing.T, bodies map[string]string, requests map[string]*atomic.Int32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if count, ok := requests[r.URL.Path]; ok {
+			count.Add(1)
+		}
+
+		body, ok := bodies[r.URL.Path]
+		if !ok {
+			t.Logf("no fixture for %s", r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Write([]byte(body))
+	}
+}
+
+// Firefly aired out of order, TMDB's season follows the broadcasts while the DVDs put the pilot first
+var fireflyFixtures = map[string]string{
+	"/tv/1437/episode_groups": `{"id": 1437, "results": [
+		{"id": "aired", "type": 1, "episode_count": 14},
+		{"id": "dvd", "type": 3, "episode_count": 14}
+	]}`,
+	"/tv/episode_group/dvd": `{"id": "dvd", "type": 3, "groups": [
+		{"name": "Season 1", "order": 1, "episodes": [
+			{"id": 101, "name": "The Train Job", "season_number": 1, "episode_number": 1, "order": 1},
+			{"id": 111, "name": "Serenity", "season_number": 1, "episode_number": 11, "order": 0},
+			{"id": 102, "name": "Bushwhacked", "season_number": 1, "episode_number": 2, "order": 2}
+		]}
+	]}`,
+	"/tv/1437/season/1": `{"season_number": 1, "episodes": [
+		{"id": 101, "name": "The Train Job", "season_number": 1, "episode_number": 1},
+		{"id": 102, "name": "Bushwhacked", "season_number": 1, "episode_number": 2}
+	]}`,
+}
+
+func TestOrderingResolverDVD(t *testing.T) {
+	groupLists := &atomic.Int32{}
+	cl := newTestClient(t, fixtures(t, fireflyFixtures, map[string]*atomic.Int32{
+		"/tv/1437/episode_groups": groupLists,
+	}))
+
+	tests := []struct {
+		season, episode int
+		wantID          int
+		wantTMDB        [2]int // TMDB's own season and episode
+	}{
+		{1, 1, 111, [2]int{1, 11}}, // The pilot comes first on the DVDs
+		{1, 2, 101, [2]int{1, 1}},  // Making the first aired episode the second
+		{1, 3, 102, [2]int{1, 2}},
+	}
+
+	resolver := cl.NewOrderingResolver("1437", DVDGrouping)
+	for _, tt := range tests {
+		episode, err := resolver.Resolve(tt.season, tt.episode)
+		if err != nil {
+			t.Errorf("Resolve(%d, %d): %v", tt.season, tt.episode, err)
+			continue
+		}
+
+		if episode.ID != tt.wantID || [2]int{episode.SeasonNumber, episode.EpisodeNumber} != tt.wantTMDB {
+			t.Errorf("Resolve(%d, %d) = %d S%02dE%02d, want %d S%02dE%02d", tt.season, tt.episode,
+				episode.ID, episode.SeasonNumber, episode.EpisodeNumber, tt.wantID, tt.wantTMDB[0], tt.wantTMDB[1])
+		}
+	}
+
+	if _, err := resolver.Resolve(1, 4); !errors.Is(err, ErrEpisodeNotFound) {
+		t.Errorf("Resolve(1, 4) err = %v, want ErrEpisodeNotFound", err)
+	}
+
+	if groupLists.Load() != 1 {
+		t.Errorf("listed the episode groups %d times, want once", groupLists.Load())
+	}
+
+	// The default ordering is TMDB's own seasons
+	episode, err := cl.NewOrderingResolver("1437", DefaultGrouping).Resolve(1, 1)
+	if err != nil || episode.ID != 101 {
+		t.Errorf("default Resolve(1, 1) = %v, %v, want The Train Job", episode, err)
+	}
+
+	if _, err := cl.NewOrderingResolver("1437", DigitalGrouping).Resolve(1, 1); !errors.Is(err, ErrNoEpisodeGroup) {
+		t.Errorf("digital Resolve(1, 1) err = %v, want ErrNoEpisodeGroup", err)
+	}
+}
+
+func TestOrderings(t *testing.T) {
+	cl := newTestClient(t, fixtures(t, fireflyFixtures, nil))
+
+	orderings, err := cl.OrderingsContext(context.Background(), "1437")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Grouping{DefaultGrouping, AirDateGrouping, DVDGrouping}
+	if len(orderings) != len(want) {
+		t.Fatalf("Orderings() = %v, want %v", orderings, want)
+	}
+
+	for i := range want {
+		if orderings[i] != want[i] {
+			t.Errorf("Orderings()[%d] = %v, want %v", i, orderings[i], want[i])
+		}
+	}
+}
+
+func TestParseGrouping(t *testing.T) {
+	for g := range groupingNames {
+		if parsed, err := ParseGrouping(g.String()); err != nil || parsed != g {
+			t.Errorf("ParseGrouping(%q) = %v, %v, want %v", g.String(), parsed, err, g)
+		}
+	}
+
+	if _, err := ParseGrouping("broadcast"); err == nil {
+		t.Error("ParseGrouping of an unknown name succeeded")
+	}
+}
@@ -0,0 +1,159 @@
+package tmdb
+
+import (
+	"context"
+	"iter"
+)
+
+// TMDB won't return search pages past this
+const MaxPage = 500
+
+// searchPages iterates over the pages of a search from start,
+// fetch is called with each page number until the last page or the iteration stops
+func searchPages[T any](ctx context.Context, start int32, fetch func(ctx context.Context, page int32) (*SearchResponse[T], error)) iter.Seq2[*SearchResponse[T], error] {
+	return func(yield func(*SearchResponse[T], error) bool) {
+		for page := max(start, 1); page <= MaxPage; page++ {
+			resp, err := fetch(ctx, page)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			if !yield(resp, nil) {
+				return
+			}
+
+			if int(page) >= resp.TotalPages || len(resp.Results) == 0 {
+				return
+			}
+		}
+	}
+}
+
+// Results flattens an iterator of search pages into an iterator of their results
+func Results[T any](pages iter.Seq2[*SearchResponse[T], error]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for page, err := range pages {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, result := range page.Results {
+				if !yield(result, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Collect gathers up to n results from seq, or all of them if n is 0 or less.
+// The results gathered before an error are returned with it.
+func Collect[T any](seq iter.Seq2[T, error], n int) ([]T, error) {
+	var results []T
+	if n <= 0 {
+		n = -1
+	}
+
+	for result, err := range seq {
+		if err != nil {
+			return results, err
+		}
+
+		results = append(results, result)
+		if n--; n == 0 {
+			break
+		}
+	}
+
+	return results, nil
+}
+
+// CollectPages gathers the results of up to n pages, or all of them if n is 0 or less.
+// The results gathered before an error are returned with it.
+func CollectPages[T any](pages iter.Seq2[*SearchResponse[T], error], n int) ([]T, error) {
+	var results []T
+	if n <= 0 {
+		n = -1
+	}
+
+	for page, err := range pages {
+		if err != nil {
+			return results, err
+		}
+
+		results = append(results, page.Results...)
+		if n--; n == 0 {
+			break
+		}
+	}
+
+	return results, nil
+}
+
+// SearchMoviePages iterates over the pages of a movie search, starting at params.Page
+func (cl *Client) SearchMoviePages(ctx context.Context, params MovieSearchParams) iter.Seq2[*SearchResponse[Movie], error] {
+	return searchPages(ctx, params.Page, func(ctx context.Context, page int32) (*SearchResponse[Movie], error) {
+		params.Page = page
+		return cl.SearchMovieContext(ctx, params)
+	})
+}
+
+// SearchMovieResults iterates over the results of a movie search, fetching pages as needed
+func (cl *Client) SearchMovieResults(ctx context.Context, params MovieSearchParams) iter.Seq2[Movie, error] {
+	return Results(cl.SearchMoviePages(ctx, params))
+}
+
+// SearchTVPages iterates over the pages of a TV search, starting at params.Page
+func (cl *Client) SearchTVPages(ctx context.Context, params TVSearchParams) iter.Seq2[*SearchResponse[TVShow], error] {
+	return searchPages(ctx, params.Page, func(ctx context.Context, page int32) (*SearchResponse[TVShow], error) {
+		params.Page = page
+		return cl.SearchTVContext(ctx, params)
+	})
+}
+
+// SearchTVResults iterates over the results of a TV search, fetching pages as needed
+func (cl *Client) SearchTVResults(ctx context.Context, params TVSearchParams) iter.Seq2[TVShow, error] {
+	return Results(cl.SearchTVPages(ctx, params))
+}
+
+// SearchMultiPages iterates over the pages of a multi search, starting at params.Page
+func (cl *Client) SearchMultiPages(ctx context.Context, params CommonSearchParams) iter.Seq2[*SearchResponse[MultiMedia], error] {
+	return searchPages(ctx, params.Page, func(ctx context.Context, page int32) (*SearchResponse[MultiMedia], error) {
+		params.Page = page
+		return cl.SearchMultiContext(ctx, params)
+	})
+}
+
+// SearchMultiResults iterates over the results of a multi search, fetching pages as needed
+func (cl *Client) SearchMultiResults(ctx context.Context, params CommonSearchParams) iter.Seq2[MultiMedia, error] {
+	return Results(cl.SearchMultiPages(ctx, params))
+}
+
+// SearchCollectionPages iterates over the pages of a collection search, starting at params.Page
+func (cl *Client) SearchCollectionPages(ctx context.Context, params CollectionSearchParams) iter.Seq2[*SearchResponse[Collection], error] {
+	return searchPages(ctx, params.Page, func(ctx context.Context, page int32) (*SearchResponse[Collection], error) {
+		params.Page = page
+		return cl.SearchCollectionContext(ctx, params)
+	})
+}
+
+// SearchCollectionResults iterates over the results of a collection search, fetching pages as needed
+func (cl *Client) SearchCollectionResults(ctx context.Context, params CollectionSearchParams) iter.Seq2[Collection, error] {
+	return Results(cl.SearchCollectionPages(ctx, params))
+}
+
+// SearchPersonPages iterates over the pages of a person search, starting at params.Page
+func (cl *Client) SearchPersonPages(ctx context.Context, params CommonSearchParams) iter.Seq2[*SearchResponse[Person], error] {
+	return searchPages(ctx, params.Page, func(ctx context.Context, page int32) (*SearchResponse[Person], error) {
+		params.Page = page
+		return cl.SearchPersonContext(ctx, params)
+	})
+}
+
+// SearchPersonResults iterates over the results of a person search, fetching pages as needed
+func (cl *Client) SearchPersonResults(ctx context.Context, params CommonSearchParams) iter.Seq2[Person, error] {
+	return Results(cl.SearchPersonPages(ctx, params))
+}
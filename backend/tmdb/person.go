@@ -0,0 +1,174 @@
+package tmdb
+
+import (
+	"context"
+	"strings"
+)
+
+type PersonDetails struct {
+	Adult              bool     `json:"adult"`
+	AlsoKnownAs        []string `json:"also_known_as"`
+	Biography          string   `json:"biography"`
+	Birthday           *string  `json:"birthday"`
+	Deathday           *string  `json:"deathday"`
+	Gender             int      `json:"gender"`
+	Homepage           *string  `json:"homepage"`
+	ID                 int      `json:"id"`
+	IMDbID             *string  `json:"imdb_id"`
+	KnownForDepartment string   `json:"known_for_department"`
+	Name               string   `json:"name"`
+	PlaceOfBirth       *string  `json:"place_of_birth"`
+	Popularity         float64  `json:"popularity"`
+	ProfilePath        *string  `json:"profile_path"`
+
+	// Append response fields
+	CombinedCredits *CombinedCreditsResponse `json:"combined_credits,omitempty"`
+	ExternalIDs     *ExternalIDsResponse     `json:"external_ids,omitempty"`
+}
+
+// PersonCredit is a movie or series a person worked on, as cast or crew.
+// Movies fill in Title and ReleaseDate, series Name and FirstAirDate.
+type PersonCredit struct {
+	MediaType        string  `json:"media_type"`
+	ID               int     `json:"id"`
+	Title            string  `json:"title,omitempty"`
+	OriginalTitle    string  `json:"original_title,omitempty"`
+	ReleaseDate      string  `json:"release_date,omitempty"`
+	Name             string  `json:"name,omitempty"`
+	OriginalName     string  `json:"original_name,omitempty"`
+	FirstAirDate     string  `json:"first_air_date,omitempty"`
+	OriginalLanguage string  `json:"original_language"`
+	Overview         string  `json:"overview"`
+	PosterPath       *string `json:"poster_path"`
+	BackdropPath     *string `json:"backdrop_path"`
+	GenreIDs         []int   `json:"genre_ids"`
+	Adult            bool    `json:"adult"`
+	Popularity       float64 `json:"popularity"`
+	VoteAverage      float64 `json:"vote_average"`
+	VoteCount        int     `json:"vote_count"`
+	CreditID         string  `json:"credit_id"`
+	Character        string  `json:"character,omitempty"`     // Cast only
+	Order            int     `json:"order,omitempty"`         // Movie cast only
+	EpisodeCount     int     `json:"episode_count,omitempty"` // Series only
+	Department       string  `json:"department,omitempty"`    // Crew only
+	Job              string  `json:"job,omitempty"`           // Crew only
+}
+
+// DisplayTitle returns the movie title or series name
+func (c *PersonCredit) DisplayTitle() string {
+	if c.MediaType == "tv" {
+		return c.Name
+	}
+
+	return c.Title
+}
+
+// Date returns the release or first air date
+func (c *PersonCredit) Date() string {
+	if c.MediaType == "tv" {
+		return c.FirstAirDate
+	}
+
+	return c.ReleaseDate
+}
+
+type CombinedCreditsResponse struct {
+	Cast []PersonCredit `json:"cast"`
+	Crew []PersonCredit `json:"crew"`
+}
+
+// ActingDepartment is the department cast credits belong to
+const ActingDepartment = "Acting"
+
+// Filter returns the credits in department with job, eg. Directing and Director, ignoring case.
+// An empty department or job matches any, and the Acting department matches the cast.
+func (c *CombinedCreditsResponse) Filter(department string, job string) []PersonCredit {
+	var credits []PersonCredit
+	if department == "" || strings.EqualFold(department, ActingDepartment) {
+		if job == "" {
+			credits = append(credits, c.Cast...)
+		}
+	}
+
+	for _, credit := range c.Crew {
+		if (department == "" || strings.EqualFold(credit.Department, department)) &&
+			(job == "" || strings.EqualFold(credit.Job, job)) {
+			credits = append(credits, credit)
+		}
+	}
+
+	return credits
+}
+
+// FilterMediaType returns the credits of mediaType, movie or tv
+func FilterMediaType(credits []PersonCredit, mediaType string) []PersonCredit {
+	var filtered []PersonCredit
+	for _, credit := range credits {
+		if credit.MediaType == mediaType {
+			filtered = append(filtered, credit)
+		}
+	}
+
+	return filtered
+}
+
+func (cl *Client) SearchPerson(params CommonSearchParams) (*SearchResponse[Person], error) {
+	return cl.SearchPersonContext(context.Background(), params)
+}
+
+// https://developer.themoviedb.org/reference/search-person
+// https://api.themoviedb.org/3/search/person
+func (cl *Client) SearchPersonContext(ctx context.Context, params CommonSearchParams) (*SearchResponse[Person], error) {
+	path := "search/person"
+	return getJSON[SearchResponse[Person]](ctx, cl, path, params)
+}
+
+func (cl *Client) SearchPersonByQuery(query string) (*SearchResponse[Person], error) {
+	return cl.SearchPersonByQueryContext(context.Background(), query)
+}
+
+func (cl *Client) SearchPersonByQueryContext(ctx context.Context, query string) (*SearchResponse[Person], error) {
+	params := CommonSearchParams{
+		Query: query,
+	}
+	return cl.SearchPersonContext(ctx, params)
+}
+
+func (cl *Client) Person(personId string, params DetailsParams) (*PersonDetails, error) {
+	return cl.PersonContext(context.Background(), personId, params)
+}
+
+// https://developer.themoviedb.org/reference/person-details
+// https://api.themoviedb.org/3/person/{person_id}
+func (cl *Client) PersonContext(ctx context.Context, personId string, params DetailsParams) (*PersonDetails, error) {
+	if len(splitAppend(params.AppendToResponse)) > MaxAppend {
+		return nil, ErrTooManyAppends
+	}
+
+	path := "person/" + personId
+	return getJSON[PersonDetails](ctx, cl, path, params)
+}
+
+// Convenience methods for simple calls without parameters
+func (cl *Client) PersonByID(personId string) (*PersonDetails, error) {
+	return cl.PersonByIDContext(context.Background(), personId)
+}
+
+func (cl *Client) PersonByIDContext(ctx context.Context, personId string) (*PersonDetails, error) {
+	return cl.PersonContext(ctx, personId, DetailsParams{})
+}
+
+type CreditsParams struct {
+	Language string `url:"language,omitempty"`
+}
+
+func (cl *Client) PersonCombinedCredits(personId string, params CreditsParams) (*CombinedCreditsResponse, error) {
+	return cl.PersonCombinedCreditsContext(context.Background(), personId, params)
+}
+
+// https://developer.themoviedb.org/reference/person-combined-credits
+// https://api.themoviedb.org/3/person/{person_id}/combined_credits
+func (cl *Client) PersonCombinedCreditsContext(ctx context.Context, personId string, params CreditsParams) (*CombinedCreditsResponse, error) {
+	path := "person/" + personId + "/combined_credits"
+	return getJSON[CombinedCreditsResponse](ctx, cl, path, params)
+}
@@ -0,0 +1,279 @@
+package tmdb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+)
+
+type GenreListParams struct {
+	Language string `url:"language,omitempty"`
+}
+
+type GenresResponse struct {
+	Genres []Genre `json:"genres"`
+}
+
+type Country struct {
+	ISO3166_1   string `json:"iso_3166_1"`
+	EnglishName string `json:"english_name"`
+	NativeName  string `json:"native_name"`
+}
+
+type CountryListParams struct {
+	Language string `url:"language,omitempty"`
+}
+
+type Timezone struct {
+	ISO3166_1 string   `json:"iso_3166_1"`
+	Zones     []string `json:"zones"`
+}
+
+// refEntry is a reference list being fetched or fetched, done is closed once data or err is set
+type refEntry struct {
+	done chan struct{}
+	data []byte
+	err  error
+}
+
+// cachedRef fetches a reference list once per key, keeping it for the life of the client.
+// Concurrent calls for the same key share one fetch, and failed fetches aren't kept, so they're retried on the next call.
+// Each caller gets its own copy, decoded from the kept JSON.
+func cachedRef[T any](ctx context.Context, cl *Client, key string, fetch func(ctx context.Context) (*T, error)) (*T, error) {
+	for {
+		cl.refMu.Lock()
+		entry, ok := cl.refs[key]
+		if !ok {
+			entry = &refEntry{done: make(chan struct{})}
+			if cl.refs == nil {
+				cl.refs = map[string]*refEntry{}
+			}
+			cl.refs[key] = entry
+		}
+		cl.refMu.Unlock()
+
+		if !ok {
+			return fetchRef(ctx, cl, key, entry, fetch)
+		}
+
+		select {
+		case <-entry.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		if entry.err != nil {
+			// The fetch was canceled by its own caller rather than failing, so try again with ours
+			if errors.Is(entry.err, context.Canceled) || errors.Is(entry.err, context.DeadlineExceeded) {
+				continue
+			}
+
+			return nil, entry.err
+		}
+
+		ref := new(T)
+		if err := json.Unmarshal(entry.data, ref); err != nil {
+			return nil, err
+		}
+
+		return ref, nil
+	}
+}
+
+// fetchRef fills in entry for cachedRef, removing it again if the fetch fails
+func fetchRef[T any](ctx context.Context, cl *Client, key string, entry *refEntry, fetch func(ctx context.Context) (*T, error)) (*T, error) {
+	defer close(entry.done)
+
+	ref, err := fetch(ctx)
+	if err == nil {
+		entry.data, err = json.Marshal(ref)
+	}
+
+	if err != nil {
+		entry.err = err
+
+		cl.refMu.Lock()
+		delete(cl.refs, key)
+		cl.refMu.Unlock()
+
+		return nil, err
+	}
+
+	return ref, nil
+}
+
+func (cl *Client) MovieGenres(params GenreListParams) (*GenresResponse, error) {
+	return cl.MovieGenresContext(context.Background(), params)
+}
+
+// https://developer.themoviedb.org/reference/genre-movie-list
+// https://api.themoviedb.org/3/genre/movie/list
+func (cl *Client) MovieGenresContext(ctx context.Context, params GenreListParams) (*GenresResponse, error) {
+	path := "genre/movie/list"
+	return cachedRef(ctx, cl, path+"?"+params.Language, func(ctx context.Context) (*GenresResponse, error) {
+		return getJSON[GenresResponse](ctx, cl, path, params)
+	})
+}
+
+func (cl *Client) TVGenres(params GenreListParams) (*GenresResponse, error) {
+	return cl.TVGenresContext(context.Background(), params)
+}
+
+// https://developer.themoviedb.org/reference/genre-tv-list
+// https://api.themoviedb.org/3/genre/tv/list
+func (cl *Client) TVGenresContext(ctx context.Context, params GenreListParams) (*GenresResponse, error) {
+	path := "genre/tv/list"
+	return cachedRef(ctx, cl, path+"?"+params.Language, func(ctx context.Context) (*GenresResponse, error) {
+		return getJSON[GenresResponse](ctx, cl, path, params)
+	})
+}
+
+func (cl *Client) Languages() ([]SpokenLanguage, error) {
+	return cl.LanguagesContext(context.Background())
+}
+
+// https://developer.themoviedb.org/reference/configuration-languages
+// https://api.themoviedb.org/3/configuration/languages
+func (cl *Client) LanguagesContext(ctx context.Context) ([]SpokenLanguage, error) {
+	path := "configuration/languages"
+	list, err := cachedRef(ctx, cl, path, func(ctx context.Context) (*[]SpokenLanguage, error) {
+		return getJSON[[]SpokenLanguage](ctx, cl, path, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return *list, nil
+}
+
+func (cl *Client) Countries(params CountryListParams) ([]Country, error) {
+	return cl.CountriesContext(context.Background(), params)
+}
+
+// https://developer.themoviedb.org/reference/configuration-countries
+// https://api.themoviedb.org/3/configuration/countries
+func (cl *Client) CountriesContext(ctx context.Context, params CountryListParams) ([]Country, error) {
+	path := "configuration/countries"
+	list, err := cachedRef(ctx, cl, path+"?"+params.Language, func(ctx context.Context) (*[]Country, error) {
+		return getJSON[[]Country](ctx, cl, path, params)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return *list, nil
+}
+
+func (cl *Client) Timezones() ([]Timezone, error) {
+	return cl.TimezonesContext(context.Background())
+}
+
+// https://developer.themoviedb.org/reference/configuration-timezones
+// https://api.themoviedb.org/3/configuration/timezones
+func (cl *Client) TimezonesContext(ctx context.Context) ([]Timezone, error) {
+	path := "configuration/timezones"
+	list, err := cachedRef(ctx, cl, path, func(ctx context.Context) (*[]Timezone, error) {
+		return getJSON[[]Timezone](ctx, cl, path, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return *list, nil
+}
+
+// GenreMap maps genre IDs to their names
+type GenreMap map[int]string
+
+// Names resolves ids, skipping any that are unknown
+func (g GenreMap) Names(ids []int) []string {
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if name, ok := g[id]; ok {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+func genreMap(genres *GenresResponse) GenreMap {
+	m := make(GenreMap, len(genres.Genres))
+	for _, genre := range genres.Genres {
+		m[genre.ID] = genre.Name
+	}
+
+	return m
+}
+
+// MovieGenreMap returns the movie genre names in language, loading them once per language
+func (cl *Client) MovieGenreMap(ctx context.Context, language string) (GenreMap, error) {
+	genres, err := cl.MovieGenresContext(ctx, GenreListParams{Language: language})
+	if err != nil {
+		return nil, err
+	}
+
+	return genreMap(genres), nil
+}
+
+// TVGenreMap returns the TV genre names in language, loading them once per language
+func (cl *Client) TVGenreMap(ctx context.Context, language string) (GenreMap, error) {
+	genres, err := cl.TVGenresContext(ctx, GenreListParams{Language: language})
+	if err != nil {
+		return nil, err
+	}
+
+	return genreMap(genres), nil
+}
+
+// EnrichMovies fills in the GenreNames of movies from their GenreIDs, in language
+func (cl *Client) EnrichMovies(ctx context.Context, language string, movies []Movie) error {
+	genres, err := cl.MovieGenreMap(ctx, language)
+	if err != nil {
+		return err
+	}
+
+	for i := range movies {
+		movies[i].GenreNames = genres.Names(movies[i].GenreIDs)
+	}
+
+	return nil
+}
+
+// EnrichTVShows fills in the GenreNames of shows from their GenreIDs, in language
+func (cl *Client) EnrichTVShows(ctx context.Context, language string, shows []TVShow) error {
+	genres, err := cl.TVGenreMap(ctx, language)
+	if err != nil {
+		return err
+	}
+
+	for i := range shows {
+		shows[i].GenreNames = genres.Names(shows[i].GenreIDs)
+	}
+
+	return nil
+}
+
+// EnrichMulti fills in the GenreNames of the movies and shows in a multi search, in language
+func (cl *Client) EnrichMulti(ctx context.Context, language string, results []MultiMedia) error {
+	movieGenres, err := cl.MovieGenreMap(ctx, language)
+	if err != nil {
+		return err
+	}
+
+	tvGenres, err := cl.TVGenreMap(ctx, language)
+	if err != nil {
+		return err
+	}
+
+	for i := range results {
+		switch results[i].MediaType {
+		case "movie":
+			results[i].Movie.GenreNames = movieGenres.Names(results[i].Movie.GenreIDs)
+		case "tv":
+			results[i].TVShow.GenreNames = tvGenres.Names(results[i].TVShow.GenreIDs)
+		}
+	}
+
+	return nil
+}
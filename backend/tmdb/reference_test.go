@@ -0,0 +1,104 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCachedRefSharesFetch(t *testing.T) {
+	var requests atomic.Int32
+	started, release := make(chan struct{}), make(chan struct{})
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Path == "/genre/movie/list" {
+			close(started)
+			<-release
+		}
+
+		w.Write([]byte(`{"genres": [{"id": 28, "name": "Action"}]}`))
+	})
+
+	var wg sync.WaitGroup
+	results := make([]*GenresResponse, 5)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			genres, err := cl.MovieGenresContext(context.Background(), GenreListParams{})
+			if err != nil {
+				t.Errorf("MovieGenres: %v", err)
+			}
+			results[i] = genres
+		}()
+	}
+
+	// Other lists aren't held up behind the slow fetch
+	<-started
+	if _, err := cl.TVGenresContext(context.Background(), GenreListParams{}); err != nil {
+		t.Fatalf("TVGenres: %v", err)
+	}
+
+	close(release)
+	wg.Wait()
+
+	if requests.Load() != 2 {
+		t.Errorf("made %d requests, want one per list", requests.Load())
+	}
+
+	// Changing one caller's list leaves the others alone
+	results[0].Genres[0].Name = "Changed"
+	for _, genres := range results[1:] {
+		if genres == nil || genres.Genres[0].Name != "Action" {
+			t.Errorf("got %v, want its own copy of Action", genres)
+		}
+	}
+}
+
+func TestCachedRefWaitCanceled(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	started := make(chan struct{})
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		w.Write([]byte(`[]`))
+	})
+
+	go cl.LanguagesContext(context.Background())
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := cl.LanguagesContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want DeadlineExceeded while waiting on the other fetch", err)
+	}
+}
+
+func TestCachedRefRetriesFailures(t *testing.T) {
+	var requests atomic.Int32
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.Write([]byte(`[{"iso_3166_1": "NL", "english_name": "Netherlands"}]`))
+	})
+
+	if _, err := cl.CountriesContext(context.Background(), CountryListParams{}); err == nil {
+		t.Fatal("first fetch succeeded, want its 401")
+	}
+
+	countries, err := cl.CountriesContext(context.Background(), CountryListParams{})
+	if err != nil || len(countries) != 1 {
+		t.Errorf("got %v, %v, want the refetched list", countries, err)
+	}
+}
@@ -0,0 +1,104 @@
+package tmdb
+
+import (
+	"context"
+	"time"
+)
+
+// seriesCacheTTL is how long a resolver keeps what it fetched, so episodes that air while the
+// app is open are found. Refetches go through the client's cache, which decides how fresh they are.
+const seriesCacheTTL = time.Hour
+
+// seriesCache fetches a series, its seasons and episode groups once each per seriesCacheTTL,
+// for resolvers that search through them. It isn't safe for concurrent use, the resolvers hold their own lock.
+type seriesCache struct {
+	cl       *Client
+	seriesId string
+
+	series  expiring[*TVSeriesDetails]
+	seasons map[int]expiring[*TVSeasonDetails]
+	groups  map[Grouping]expiring[*EpisodeGroupDetails] // By type, nil when the series has none of that type
+}
+
+type expiring[T any] struct {
+	value   T
+	fetched time.Time
+}
+
+func fresh[T any](value T) expiring[T] {
+	return expiring[T]{value, time.Now()}
+}
+
+func (e expiring[T]) expired() bool {
+	return time.Since(e.fetched) > seriesCacheTTL
+}
+
+func newSeriesCache(cl *Client, seriesId string) seriesCache {
+	return seriesCache{
+		cl:       cl,
+		seriesId: seriesId,
+		seasons:  map[int]expiring[*TVSeasonDetails]{},
+		groups:   map[Grouping]expiring[*EpisodeGroupDetails]{},
+	}
+}
+
+func (c *seriesCache) details(ctx context.Context) (*TVSeriesDetails, error) {
+	if c.series.value != nil && !c.series.expired() {
+		return c.series.value, nil
+	}
+
+	series, err := c.cl.TVSeriesByIDContext(ctx, c.seriesId)
+	if err != nil {
+		return nil, err
+	}
+
+	c.series = fresh(series)
+	return series, nil
+}
+
+func (c *seriesCache) season(ctx context.Context, seasonNum int) (*TVSeasonDetails, error) {
+	if season, ok := c.seasons[seasonNum]; ok && !season.expired() {
+		return season.value, nil
+	}
+
+	season, err := c.cl.TVSeasonByIDContext(ctx, c.seriesId, seasonNum)
+	if err != nil {
+		return nil, err
+	}
+
+	c.seasons[seasonNum] = fresh(season)
+	return season, nil
+}
+
+// group returns the series' episode group of groupType, eg. AbsoluteGrouping, or nil if it has none.
+// When there's more than one, the one with the most episodes is taken.
+func (c *seriesCache) group(ctx context.Context, groupType Grouping) (*EpisodeGroupDetails, error) {
+	if group, ok := c.groups[groupType]; ok && !group.expired() {
+		return group.value, nil
+	}
+
+	list, err := c.cl.EpisodeGroupsContext(ctx, c.seriesId)
+	if err != nil {
+		return nil, err
+	}
+
+	var best *EpisodeGroup
+	for i, group := range list.Results {
+		if group.Type == groupType && (best == nil || group.EpisodeCount > best.EpisodeCount) {
+			best = &list.Results[i]
+		}
+	}
+
+	if best == nil {
+		c.groups[groupType] = fresh[*EpisodeGroupDetails](nil)
+		return nil, nil
+	}
+
+	group, err := c.cl.EpisodesGroupedByContext(ctx, best.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.groups[groupType] = fresh(group)
+	return group, nil
+}
@@ -0,0 +1,82 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried.
+// Rate limited (429) and transient server (5xx) responses and network errors are retried,
+// waiting for the Retry-After duration if the response has one,
+// otherwise for an exponential backoff with jitter.
+type RetryPolicy struct {
+	MaxRetries    int           // Retries after the first attempt, 0 disables retrying
+	MinBackoff    time.Duration // Backoff before the first retry
+	MaxBackoff    time.Duration // Upper bound for the exponential backoff
+	MaxRetryAfter time.Duration // Give up rather than honour a longer Retry-After, 0 for no limit
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries:    3,
+	MinBackoff:    500 * time.Millisecond,
+	MaxBackoff:    10 * time.Second,
+	MaxRetryAfter: time.Minute,
+}
+
+// NoRetry disables retrying
+var NoRetry = RetryPolicy{}
+
+// backoff returns a jittered exponential backoff for the given attempt, starting at 0
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.MinBackoff
+	for range attempt {
+		if d >= p.MaxBackoff {
+			break
+		}
+		d *= 2
+	}
+	d = min(d, p.MaxBackoff)
+
+	if d <= 0 {
+		return 0
+	}
+
+	// Equal jitter, wait between half and the full backoff
+	return d/2 + rand.N(d/2+1)
+}
+
+// retryDelay reports whether a request should be retried after the given attempt,
+// and how long to wait before doing so
+func (p RetryPolicy) retryDelay(attempt int, resp *http.Response, err error) (time.Duration, bool) {
+	if attempt >= p.MaxRetries {
+		return 0, false
+	}
+
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return 0, false
+		}
+
+		return p.backoff(attempt), true
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		if wait := parseRetryAfter(resp.Header.Get("Retry-After")); wait > 0 {
+			if p.MaxRetryAfter > 0 && wait > p.MaxRetryAfter {
+				return 0, false
+			}
+
+			return wait, true
+		}
+
+		return p.backoff(attempt), true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout:
+		return p.backoff(attempt), true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,186 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fastRetry retries without waiting long, so tests don't sleep through real backoffs
+var fastRetry = RetryPolicy{
+	MaxRetries:    3,
+	MinBackoff:    time.Millisecond,
+	MaxBackoff:    time.Millisecond,
+	MaxRetryAfter: time.Minute,
+}
+
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts ...Option) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return NewClient("key", append([]Option{WithBaseURL(server.URL), WithRateLimit(0, 0)}, opts...)...)
+}
+
+func TestRetryAfterPausesClient(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		limited  time.Time
+		arrivals = map[string]time.Time{}
+	)
+	sent := make(chan struct{})
+
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.URL.Path == "/tv/1" && limited.IsZero() {
+			limited = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			close(sent)
+			return
+		}
+
+		arrivals[r.URL.Path] = time.Now()
+		w.Write([]byte(`{}`))
+	}, WithRateLimit(100, 10), WithRetryPolicy(fastRetry))
+
+	done := make(chan error)
+	go func() {
+		_, err := cl.TVSeriesByIDContext(context.Background(), "1")
+		done <- err
+	}()
+
+	// Another request made while the first waits out its Retry-After is held back too
+	<-sent
+	time.Sleep(100 * time.Millisecond)
+	if _, err := cl.TVSeriesByIDContext(context.Background(), "2"); err != nil {
+		t.Fatalf("second request: %v", err)
+	}
+
+	if err := <-done; err != nil {
+		t.Fatalf("rate limited request: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	for _, path := range []string{"/tv/1", "/tv/2"} {
+		if waited := arrivals[path].Sub(limited); waited < 900*time.Millisecond {
+			t.Errorf("%s arrived %v after the 429, want at least the 1s Retry-After", path, waited)
+		}
+	}
+}
+
+func TestRetryServerErrors(t *testing.T) {
+	var requests atomic.Int32
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}, WithRetryPolicy(fastRetry))
+
+	_, err := cl.TVSeriesByIDContext(context.Background(), "1")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadGateway {
+		t.Fatalf("err = %v, want a 502 APIError", err)
+	}
+
+	if got, want := requests.Load(), int32(fastRetry.MaxRetries+1); got != want {
+		t.Errorf("made %d requests, want %d", got, want)
+	}
+}
+
+func TestRetryServerErrorRecovers(t *testing.T) {
+	var requests atomic.Int32
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Write([]byte(`{"id": 1}`))
+	}, WithRetryPolicy(fastRetry))
+
+	series, err := cl.TVSeriesByIDContext(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("err = %v, want the retry to succeed", err)
+	}
+
+	if series.ID != 1 || requests.Load() != 2 {
+		t.Errorf("got series %d after %d requests, want series 1 after 2", series.ID, requests.Load())
+	}
+}
+
+func TestRetryNotFoundNotRetried(t *testing.T) {
+	var requests atomic.Int32
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	}, WithRetryPolicy(fastRetry))
+
+	_, err := cl.TVSeriesByIDContext(context.Background(), "1")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("err = %v, want a 404 APIError", err)
+	}
+
+	if requests.Load() != 1 {
+		t.Errorf("made %d requests, want 1", requests.Load())
+	}
+}
+
+func TestMaxRetryAfterGivesUp(t *testing.T) {
+	var requests atomic.Int32
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Retry-After", "120")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}, WithRetryPolicy(RetryPolicy{MaxRetries: 3, MaxRetryAfter: time.Second}))
+
+	start := time.Now()
+	_, err := cl.TVSeriesByIDContext(context.Background(), "1")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("err = %v, want a 429 APIError", err)
+	}
+
+	if apiErr.RetryAfter != 2*time.Minute {
+		t.Errorf("RetryAfter = %v, want 2m", apiErr.RetryAfter)
+	}
+
+	if requests.Load() != 1 || time.Since(start) > time.Second {
+		t.Errorf("made %d requests in %v, want 1 without waiting", requests.Load(), time.Since(start))
+	}
+}
+
+func TestRetryStopsWhenCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var requests atomic.Int32
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}, WithRetryPolicy(RetryPolicy{MaxRetries: 3, MinBackoff: time.Minute, MaxBackoff: time.Minute}))
+
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	start := time.Now()
+	if _, err := cl.TVSeriesByIDContext(ctx, "1"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+
+	if requests.Load() != 1 || time.Since(start) > 5*time.Second {
+		t.Errorf("made %d requests in %v, want 1 ended by the cancel", requests.Load(), time.Since(start))
+	}
+}
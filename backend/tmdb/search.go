@@ -1,128 +1,98 @@
 package tmdb
 
-import (
-	"encoding/json"
-	"fmt"
-	"io"
-	"net/http"
-
-	"github.com/google/go-querystring/query"
-)
+import "context"
 
 func (cl *Client) SearchMovie(params MovieSearchParams) (*SearchResponse[Movie], error) {
-	queryParams, err := query.Values(params)
-	if err != nil {
-		return nil, err
-	}
-	path := "search/movie"
-
-	resp, err := cl.get(path, queryParams)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	var searchResp SearchResponse[Movie]
-	if err := json.Unmarshal(body, &searchResp); err != nil {
-		return nil, err
-	}
+	return cl.SearchMovieContext(context.Background(), params)
+}
 
-	return &searchResp, nil
+// https://developer.themoviedb.org/reference/search-movie
+// https://api.themoviedb.org/3/search/movie
+func (cl *Client) SearchMovieContext(ctx context.Context, params MovieSearchParams) (*SearchResponse[Movie], error) {
+	path := "search/movie"
+	return getJSON[SearchResponse[Movie]](ctx, cl, path, params)
 }
 
 func (cl *Client) SearchTV(params TVSearchParams) (*SearchResponse[TVShow], error) {
-	queryParams, err := query.Values(params)
-	if err != nil {
-		return nil, err
-	}
-	path := "search/tv"
-
-	resp, err := cl.get(path, queryParams)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
+	return cl.SearchTVContext(context.Background(), params)
+}
 
-	var searchResp SearchResponse[TVShow]
-	if err := json.Unmarshal(body, &searchResp); err != nil {
-		return nil, err
-	}
+// https://developer.themoviedb.org/reference/search-tv
+// https://api.themoviedb.org/3/search/tv
+func (cl *Client) SearchTVContext(ctx context.Context, params TVSearchParams) (*SearchResponse[TVShow], error) {
+	path := "search/tv"
+	return getJSON[SearchResponse[TVShow]](ctx, cl, path, params)
+}
 
-	return &searchResp, nil
+func (cl *Client) SearchMulti(params CommonSearchParams) (*SearchResponse[MultiMedia], error) {
+	return cl.SearchMultiContext(context.Background(), params)
 }
 
 // https://developer.themoviedb.org/reference/search-multi
 // https://api.themoviedb.org/3/search/multi
-func (cl *Client) SearchMulti(params CommonSearchParams) (*SearchResponse[MultiMedia], error) {
-	queryParams, err := query.Values(params)
-	if err != nil {
-		return nil, err
-	}
+func (cl *Client) SearchMultiContext(ctx context.Context, params CommonSearchParams) (*SearchResponse[MultiMedia], error) {
 	path := "search/multi"
+	return getJSON[SearchResponse[MultiMedia]](ctx, cl, path, params)
+}
 
-	resp, err := cl.get(path, queryParams)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	var searchResp SearchResponse[MultiMedia]
-	if err := json.Unmarshal(body, &searchResp); err != nil {
-		return nil, err
-	}
+func (cl *Client) SearchCollection(params CollectionSearchParams) (*SearchResponse[Collection], error) {
+	return cl.SearchCollectionContext(context.Background(), params)
+}
 
-	return &searchResp, nil
+// https://developer.themoviedb.org/reference/search-collection
+// https://api.themoviedb.org/3/search/collection
+func (cl *Client) SearchCollectionContext(ctx context.Context, params CollectionSearchParams) (*SearchResponse[Collection], error) {
+	path := "search/collection"
+	return getJSON[SearchResponse[Collection]](ctx, cl, path, params)
 }
 
 // Convenience methods for simple query-only searches
 func (cl *Client) SearchMovieByQuery(query string) (*SearchResponse[Movie], error) {
+	return cl.SearchMovieByQueryContext(context.Background(), query)
+}
+
+func (cl *Client) SearchMovieByQueryContext(ctx context.Context, query string) (*SearchResponse[Movie], error) {
 	params := MovieSearchParams{
 		CommonSearchParams: CommonSearchParams{
 			Query: query,
 		},
 	}
-	return cl.SearchMovie(params)
+	return cl.SearchMovieContext(ctx, params)
 }
 
 func (cl *Client) SearchTVByQuery(query string) (*SearchResponse[TVShow], error) {
+	return cl.SearchTVByQueryContext(context.Background(), query)
+}
+
+func (cl *Client) SearchTVByQueryContext(ctx context.Context, query string) (*SearchResponse[TVShow], error) {
 	params := TVSearchParams{
 		CommonSearchParams: CommonSearchParams{
 			Query: query,
 		},
 	}
-	return cl.SearchTV(params)
+	return cl.SearchTVContext(ctx, params)
 }
 
 func (cl *Client) SearchMultiByQuery(query string) (*SearchResponse[MultiMedia], error) {
+	return cl.SearchMultiByQueryContext(context.Background(), query)
+}
+
+func (cl *Client) SearchMultiByQueryContext(ctx context.Context, query string) (*SearchResponse[MultiMedia], error) {
 	params := CommonSearchParams{
 		Query: query,
 	}
-	return cl.SearchMulti(params)
+	return cl.SearchMultiContext(ctx, params)
+}
+
+func (cl *Client) SearchCollectionByQuery(query string) (*SearchResponse[Collection], error) {
+	return cl.SearchCollectionByQueryContext(context.Background(), query)
+}
+
+func (cl *Client) SearchCollectionByQueryContext(ctx context.Context, query string) (*SearchResponse[Collection], error) {
+	params := CollectionSearchParams{
+		CommonSearchParams: CommonSearchParams{
+			Query: query,
+		},
+	}
+	return cl.SearchCollectionContext(ctx, params)
 }
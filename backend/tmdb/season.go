@@ -1,48 +1,41 @@
 package tmdb
 
 import (
-	"encoding/json"
-	"fmt"
-	"io"
-	"net/http"
+	"context"
 	"strconv"
-
-	"github.com/google/go-querystring/query"
 )
 
-// https://developer.themoviedb.org/reference/tv-season-details
-// https://api.themoviedb.org/3/tv/{series_id}/season/{season_number}
 func (cl *Client) TVSeason(seriesId string, seasonNum int, params DetailsParams) (*TVSeasonDetails, error) {
-	queryParams, err := query.Values(params)
-	if err != nil {
-		return nil, err
-	}
-	path := "tv/" + seriesId + "/season/" + strconv.Itoa(seasonNum)
-
-	resp, err := cl.get(path, queryParams)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	return cl.TVSeasonContext(context.Background(), seriesId, seasonNum, params)
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
+// https://developer.themoviedb.org/reference/tv-season-details
+// https://api.themoviedb.org/3/tv/{series_id}/season/{season_number}
+func (cl *Client) TVSeasonContext(ctx context.Context, seriesId string, seasonNum int, params DetailsParams) (*TVSeasonDetails, error) {
+	if len(splitAppend(params.AppendToResponse)) > MaxAppend {
+		return nil, ErrTooManyAppends
 	}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
+	path := "tv/" + seriesId + "/season/" + strconv.Itoa(seasonNum)
+	return getJSON[TVSeasonDetails](ctx, cl, path, params)
+}
 
-	var seasonDetails TVSeasonDetails
-	if err := json.Unmarshal(body, &seasonDetails); err != nil {
-		return nil, err
+// Episode returns the episode numbered num, or nil if the season doesn't have it
+func (s *TVSeasonDetails) Episode(num int) *Episode {
+	for i := range s.Episodes {
+		if s.Episodes[i].EpisodeNumber == num {
+			return &s.Episodes[i]
+		}
 	}
 
-	return &seasonDetails, nil
+	return nil
 }
 
 // Convenience methods for simple calls without parameters
 func (cl *Client) TVSeasonByID(seriesId string, seasonNum int) (*TVSeasonDetails, error) {
-	return cl.TVSeason(seriesId, seasonNum, DetailsParams{})
+	return cl.TVSeasonByIDContext(context.Background(), seriesId, seasonNum)
+}
+
+func (cl *Client) TVSeasonByIDContext(ctx context.Context, seriesId string, seasonNum int) (*TVSeasonDetails, error) {
+	return cl.TVSeasonContext(ctx, seriesId, seasonNum, DetailsParams{})
 }
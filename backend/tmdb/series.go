@@ -1,76 +1,85 @@
 package tmdb
 
 import (
-	"encoding/json"
-	"fmt"
-	"io"
-	"net/http"
-	"net/url"
-
-	"github.com/google/go-querystring/query"
+	"context"
+	"sort"
+	"strings"
 )
 
+func (cl *Client) TVSeries(seriesId string, params DetailsParams) (*TVSeriesDetails, error) {
+	return cl.TVSeriesContext(context.Background(), seriesId, params)
+}
+
 // https://developer.themoviedb.org/reference/tv-series-details
 // https://api.themoviedb.org/3/tv/{series_id}
-func (cl *Client) TVSeries(seriesId string, params DetailsParams) (*TVSeriesDetails, error) {
-	queryParams, err := query.Values(params)
-	if err != nil {
-		return nil, err
-	}
+//
+// More than MaxAppend keys in params.AppendToResponse, eg. 30 seasons, are requested over several calls,
+// with their FullSeasons merged into the first response.
+func (cl *Client) TVSeriesContext(ctx context.Context, seriesId string, params DetailsParams) (*TVSeriesDetails, error) {
 	path := "tv/" + seriesId
 
-	resp, err := cl.get(path, queryParams)
-	if err != nil {
-		return nil, err
+	keys := splitAppend(params.AppendToResponse)
+	if len(keys) <= MaxAppend {
+		return getJSON[TVSeriesDetails](ctx, cl, path, params)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
-	}
-
-	body, err := io.ReadAll(resp.Body)
+	chunks, err := chunkAppend(keys)
 	if err != nil {
 		return nil, err
 	}
 
-	var tvDetails TVSeriesDetails
-	if err := json.Unmarshal(body, &tvDetails); err != nil {
-		return nil, err
+	var details *TVSeriesDetails
+	for _, chunk := range chunks {
+		chunkParams := params
+		chunkParams.AppendToResponse = strings.Join(chunk, ",")
+
+		more, err := getJSON[TVSeriesDetails](ctx, cl, path, chunkParams)
+		if err != nil {
+			return nil, err
+		}
+
+		if details == nil {
+			details = more
+			continue
+		}
+
+		details.FullSeasons = append(details.FullSeasons, more.FullSeasons...)
 	}
 
-	return &tvDetails, nil
+	sort.SliceStable(details.FullSeasons, func(i, j int) bool {
+		return details.FullSeasons[i].SeasonNumber < details.FullSeasons[j].SeasonNumber
+	})
+
+	return details, nil
 }
 
 // Convenience methods for simple calls without parameters
 func (cl *Client) TVSeriesByID(seriesId string) (*TVSeriesDetails, error) {
-	return cl.TVSeries(seriesId, DetailsParams{})
+	return cl.TVSeriesByIDContext(context.Background(), seriesId)
+}
+
+func (cl *Client) TVSeriesByIDContext(ctx context.Context, seriesId string) (*TVSeriesDetails, error) {
+	return cl.TVSeriesContext(ctx, seriesId, DetailsParams{})
+}
+
+func (cl *Client) EpisodeGroups(seriesId string) (*EpisodeGroupList, error) {
+	return cl.EpisodeGroupsContext(context.Background(), seriesId)
 }
 
 // https://developer.themoviedb.org/reference/tv-series-episode-groups
 // https://api.themoviedb.org/3/tv/{series_id}/episode_groups
-func (cl *Client) EpisodeGroups(seriesId string) (*EpisodeGroupList, error) {
+func (cl *Client) EpisodeGroupsContext(ctx context.Context, seriesId string) (*EpisodeGroupList, error) {
 	path := "tv/" + seriesId + "/episode_groups"
+	return getJSON[EpisodeGroupList](ctx, cl, path, nil)
+}
 
-	resp, err := cl.get(path, url.Values{})
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	var episodeGroups EpisodeGroupList
-	if err := json.Unmarshal(body, &episodeGroups); err != nil {
-		return nil, err
-	}
+func (cl *Client) TVSeriesExternalIDs(seriesId string) (*ExternalIDsResponse, error) {
+	return cl.TVSeriesExternalIDsContext(context.Background(), seriesId)
+}
 
-	return &episodeGroups, nil
+// https://developer.themoviedb.org/reference/tv-series-external-ids
+// https://api.themoviedb.org/3/tv/{series_id}/external_ids
+func (cl *Client) TVSeriesExternalIDsContext(ctx context.Context, seriesId string) (*ExternalIDsResponse, error) {
+	path := "tv/" + seriesId + "/external_ids"
+	return getJSON[ExternalIDsResponse](ctx, cl, path, nil)
 }
@@ -0,0 +1,239 @@
+package tmdb
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+type AlternativeTitlesParams struct {
+	Country string `url:"country,omitempty"` // ISO 3166-1 code, movies only
+}
+
+type AlternativeTitle struct {
+	ISO3166_1 string `json:"iso_3166_1"`
+	Title     string `json:"title"`
+	Type      string `json:"type"` // Free form, eg. romanization or working title
+}
+
+type AlternativeTitlesResponse struct {
+	Titles []AlternativeTitle `json:"titles"`
+}
+
+func (a *AlternativeTitlesResponse) UnmarshalJSON(data []byte) error {
+	// Movies list their titles under titles, series under results
+	var raw struct {
+		Titles  []AlternativeTitle `json:"titles"`
+		Results []AlternativeTitle `json:"results"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	a.Titles = append(raw.Titles, raw.Results...)
+	return nil
+}
+
+type MovieTranslationData struct {
+	Title    string `json:"title"`
+	Overview string `json:"overview"`
+	Tagline  string `json:"tagline"`
+	Homepage string `json:"homepage"`
+	Runtime  int    `json:"runtime"`
+}
+
+type TVTranslationData struct {
+	Name     string `json:"name"`
+	Overview string `json:"overview"`
+	Tagline  string `json:"tagline"`
+	Homepage string `json:"homepage"`
+}
+
+func (cl *Client) MovieAlternativeTitles(movieId string, params AlternativeTitlesParams) (*AlternativeTitlesResponse, error) {
+	return cl.MovieAlternativeTitlesContext(context.Background(), movieId, params)
+}
+
+// https://developer.themoviedb.org/reference/movie-alternative-titles
+// https://api.themoviedb.org/3/movie/{movie_id}/alternative_titles
+func (cl *Client) MovieAlternativeTitlesContext(ctx context.Context, movieId string, params AlternativeTitlesParams) (*AlternativeTitlesResponse, error) {
+	path := "movie/" + movieId + "/alternative_titles"
+	return getJSON[AlternativeTitlesResponse](ctx, cl, path, params)
+}
+
+func (cl *Client) TVSeriesAlternativeTitles(seriesId string) (*AlternativeTitlesResponse, error) {
+	return cl.TVSeriesAlternativeTitlesContext(context.Background(), seriesId)
+}
+
+// https://developer.themoviedb.org/reference/tv-series-alternative-titles
+// https://api.themoviedb.org/3/tv/{series_id}/alternative_titles
+func (cl *Client) TVSeriesAlternativeTitlesContext(ctx context.Context, seriesId string) (*AlternativeTitlesResponse, error) {
+	path := "tv/" + seriesId + "/alternative_titles"
+	return getJSON[AlternativeTitlesResponse](ctx, cl, path, nil)
+}
+
+func (cl *Client) MovieTranslations(movieId string) (*TranslationsResponse[MovieTranslationData], error) {
+	return cl.MovieTranslationsContext(context.Background(), movieId)
+}
+
+// https://developer.themoviedb.org/reference/movie-translations
+// https://api.themoviedb.org/3/movie/{movie_id}/translations
+func (cl *Client) MovieTranslationsContext(ctx context.Context, movieId string) (*TranslationsResponse[MovieTranslationData], error) {
+	path := "movie/" + movieId + "/translations"
+	return getJSON[TranslationsResponse[MovieTranslationData]](ctx, cl, path, nil)
+}
+
+func (cl *Client) TVSeriesTranslations(seriesId string) (*TranslationsResponse[TVTranslationData], error) {
+	return cl.TVSeriesTranslationsContext(context.Background(), seriesId)
+}
+
+// https://developer.themoviedb.org/reference/tv-series-translations
+// https://api.themoviedb.org/3/tv/{series_id}/translations
+func (cl *Client) TVSeriesTranslationsContext(ctx context.Context, seriesId string) (*TranslationsResponse[TVTranslationData], error) {
+	path := "tv/" + seriesId + "/translations"
+	return getJSON[TranslationsResponse[TVTranslationData]](ctx, cl, path, nil)
+}
+
+// localizedTitle is a translated or alternative title and where it applies
+type localizedTitle struct {
+	language string
+	region   string
+	title    string
+}
+
+// splitLocale splits a locale like pt-BR into its language and region, either may be empty
+func splitLocale(locale string) (language string, region string) {
+	language, region, _ = strings.Cut(strings.ReplaceAll(locale, "_", "-"), "-")
+
+	// A bare region, like JP, is upper case
+	if region == "" && language == strings.ToUpper(language) {
+		return "", language
+	}
+
+	return strings.ToLower(language), strings.ToUpper(region)
+}
+
+// pickTitle picks a display title for the first of locales, eg. ja-JP, ja or JP, that has one.
+// For each locale it tries a translation matching language and region, a translation matching the language,
+// then an alternative title for the region, skipping empty translations.
+// If none match, the original title is used if its language is one of the locales, then fallback.
+func pickTitle(locales []string, translated []localizedTitle, alternatives []AlternativeTitle, originalLanguage string, original string, fallback string) string {
+	for _, locale := range locales {
+		language, region := splitLocale(locale)
+
+		if language != "" {
+			for _, exact := range []bool{true, false} {
+				for _, t := range translated {
+					if t.title != "" && t.language == language && (!exact || region == "" || t.region == region) {
+						return t.title
+					}
+				}
+			}
+		}
+
+		if region != "" {
+			for _, alt := range alternatives {
+				if alt.Title != "" && alt.ISO3166_1 == region {
+					return alt.Title
+				}
+			}
+		}
+
+		if language != "" && language == originalLanguage && original != "" {
+			return original
+		}
+	}
+
+	if fallback != "" {
+		return fallback
+	}
+
+	return original
+}
+
+// DisplayTitle picks the movie's title for the first of locales, eg. ja-JP, ja or JP, that has one.
+// Uses the appended translations and alternative_titles, see pickTitle for the fallback chain.
+func (m *MovieDetails) DisplayTitle(locales ...string) string {
+	var translated []localizedTitle
+	if m.Translations != nil {
+		for _, t := range m.Translations.Translations {
+			translated = append(translated, localizedTitle{t.ISO639_1, t.ISO3166_1, t.Data.Title})
+		}
+	}
+
+	var alternatives []AlternativeTitle
+	if m.AlternativeTitles != nil {
+		alternatives = m.AlternativeTitles.Titles
+	}
+
+	return pickTitle(locales, translated, alternatives, m.OriginalLanguage, m.OriginalTitle, m.Title)
+}
+
+// DisplayName picks the series' name for the first of locales, eg. ja-JP, ja or JP, that has one.
+// Uses the appended translations and alternative_titles, see pickTitle for the fallback chain.
+func (t *TVSeriesDetails) DisplayName(locales ...string) string {
+	var translated []localizedTitle
+	if t.Translations != nil {
+		for _, tr := range t.Translations.Translations {
+			translated = append(translated, localizedTitle{tr.ISO639_1, tr.ISO3166_1, tr.Data.Name})
+		}
+	}
+
+	var alternatives []AlternativeTitle
+	if t.AlternativeTitles != nil {
+		alternatives = t.AlternativeTitles.Titles
+	}
+
+	return pickTitle(locales, translated, alternatives, t.OriginalLanguage, t.OriginalName, t.Name)
+}
+
+// uniqueTitles returns the non empty titles, without duplicates, in order
+func uniqueTitles(titles ...string) []string {
+	var unique []string
+	seen := map[string]bool{}
+	for _, title := range titles {
+		if title == "" || seen[title] {
+			continue
+		}
+
+		seen[title] = true
+		unique = append(unique, title)
+	}
+
+	return unique
+}
+
+// AllTitles returns every known title of the movie, for matching filenames in any language
+func (m *MovieDetails) AllTitles() []string {
+	titles := []string{m.Title, m.OriginalTitle}
+	if m.Translations != nil {
+		for _, t := range m.Translations.Translations {
+			titles = append(titles, t.Data.Title)
+		}
+	}
+
+	if m.AlternativeTitles != nil {
+		for _, alt := range m.AlternativeTitles.Titles {
+			titles = append(titles, alt.Title)
+		}
+	}
+
+	return uniqueTitles(titles...)
+}
+
+// AllNames returns every known name of the series, for matching filenames in any language
+func (t *TVSeriesDetails) AllNames() []string {
+	names := []string{t.Name, t.OriginalName}
+	if t.Translations != nil {
+		for _, tr := range t.Translations.Translations {
+			names = append(names, tr.Data.Name)
+		}
+	}
+
+	if t.AlternativeTitles != nil {
+		for _, alt := range t.AlternativeTitles.Titles {
+			names = append(names, alt.Title)
+		}
+	}
+
+	return uniqueTitles(names...)
+}
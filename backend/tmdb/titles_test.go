@@ -0,0 +1,84 @@
+package tmdb
+
+import "testing"
+
+func TestSplitLocale(t *testing.T) {
+	tests := []struct {
+		locale, language, region string
+	}{
+		{"pt-BR", "pt", "BR"},
+		{"pt_br", "pt", "BR"},
+		{"ja", "ja", ""},
+		{"JP", "", "JP"},
+		{"", "", ""},
+	}
+
+	for _, tt := range tests {
+		if language, region := splitLocale(tt.locale); language != tt.language || region != tt.region {
+			t.Errorf("splitLocale(%q) = %q, %q, want %q, %q", tt.locale, language, region, tt.language, tt.region)
+		}
+	}
+}
+
+func TestPickTitle(t *testing.T) {
+	translated := []localizedTitle{
+		{"en", "US", "Spirited Away"},
+		{"pt", "BR", "A Viagem de Chihiro"},
+		{"pt", "PT", "A Viagem de Chihiro (PT)"},
+		{"de", "DE", ""}, // Translated overview, but not the title
+		{"fr", "FR", "Le Voyage de Chihiro"},
+	}
+	alternatives := []AlternativeTitle{
+		{ISO3166_1: "JP", Title: "Sen to Chihiro no Kamikakushi", Type: "romaji"},
+		{ISO3166_1: "DE", Title: ""},
+		{ISO3166_1: "HK", Title: "千與千尋"},
+	}
+	const original, fallback = "千と千尋の神隠し", "Spirited Away (fallback)"
+
+	tests := []struct {
+		locales []string
+		want    string
+	}{
+		{[]string{"pt-BR"}, "A Viagem de Chihiro"},
+		{[]string{"pt-PT"}, "A Viagem de Chihiro (PT)"},
+		{[]string{"pt"}, "A Viagem de Chihiro"},              // First translation in the language
+		{[]string{"pt-AO"}, "A Viagem de Chihiro"},           // No exact match, so any in the language
+		{[]string{"zh-HK"}, "千與千尋"},                          // No translation, so the region's alternative title
+		{[]string{"JP"}, "Sen to Chihiro no Kamikakushi"},    // A bare region only has alternatives
+		{[]string{"ja-JP"}, "Sen to Chihiro no Kamikakushi"}, // The alternative comes before the original
+		{[]string{"ja"}, original},                           // The original is in the wanted language
+		{[]string{"de-DE", "fr-FR"}, "Le Voyage de Chihiro"}, // Empty titles are skipped on to the next locale
+		{[]string{"de-DE"}, fallback},                        // Nothing for any of them
+		{nil, fallback},
+	}
+
+	for _, tt := range tests {
+		if got := pickTitle(tt.locales, translated, alternatives, "ja", original, fallback); got != tt.want {
+			t.Errorf("pickTitle(%v) = %q, want %q", tt.locales, got, tt.want)
+		}
+	}
+
+	// Without a fallback the original is used
+	if got := pickTitle([]string{"de"}, translated, alternatives, "ja", original, ""); got != original {
+		t.Errorf("pickTitle() without a fallback = %q, want the original", got)
+	}
+}
+
+func TestDisplayTitle(t *testing.T) {
+	movie := MovieDetails{
+		Title:            "Spirited Away",
+		OriginalTitle:    "千と千尋の神隠し",
+		OriginalLanguage: "ja",
+		Translations: &TranslationsResponse[MovieTranslationData]{Translations: []Translation[MovieTranslationData]{
+			{ISO639_1: "fr", ISO3166_1: "FR", Data: MovieTranslationData{Title: "Le Voyage de Chihiro"}},
+		}},
+	}
+
+	if got := movie.DisplayTitle("fr-CA", "en"); got != "Le Voyage de Chihiro" {
+		t.Errorf("DisplayTitle() = %q, want the French title", got)
+	}
+
+	if got := movie.DisplayTitle("es"); got != "Spirited Away" {
+		t.Errorf("DisplayTitle() = %q, want the TMDB title", got)
+	}
+}
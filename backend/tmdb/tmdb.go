@@ -1,24 +1,110 @@
 package tmdb
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/go-querystring/query"
 )
 
 type Client struct {
-	baseURL string
-	key     string
+	baseURL   string
+	key       string
+	authMode  AuthMode
+	userAgent string
+	http      *http.Client
+	limiter   *rateLimiter
+	retry     RetryPolicy
+
+	cache    Cache
+	cacheTTL TTLFunc
+	offline  atomic.Bool
+
+	onRequest  []RequestHook
+	onResponse []ResponseHook
+
+	configMu      sync.Mutex
+	config        *Configuration
+	configExpires time.Time
+
+	refMu sync.Mutex
+	refs  map[string]*refEntry // Reference lists by path and language, see cachedRef
 }
 
-func NewClient(key string) *Client {
-	return &Client{
-		key:     key,
-		baseURL: "https://api.themoviedb.org/3",
+const DefaultBaseURL = "https://api.themoviedb.org/3"
+
+// TMDB allows around 50 requests per second, stay a little under it
+const (
+	DefaultRateLimit = 40
+	DefaultBurst     = 20
+)
+
+func NewClient(key string, opts ...Option) *Client {
+	cl := &Client{
+		key:      key,
+		baseURL:  DefaultBaseURL,
+		http:     &http.Client{},
+		limiter:  newRateLimiter(DefaultRateLimit, DefaultBurst),
+		retry:    DefaultRetryPolicy,
+		cacheTTL: DefaultTTL,
 	}
+
+	for _, opt := range opts {
+		opt(cl)
+	}
+
+	return cl
+}
+
+// SetOffline toggles offline mode. When offline, responses are only served from the cache,
+// including expired ones, and anything not cached fails with ErrOffline.
+func (cl *Client) SetOffline(offline bool) {
+	cl.offline.Store(offline)
 }
 
-func (cl *Client) get(path string, params url.Values) (res *http.Response, err error) {
+func (cl *Client) Offline() bool {
+	return cl.offline.Load()
+}
+
+// getJSON requests path, with params encoded through their url tags,
+// and decodes the JSON response into a T. Non 200 responses are returned as an *APIError.
+func getJSON[T any](ctx context.Context, cl *Client, path string, params any) (*T, error) {
+	values := url.Values{}
+	if params != nil {
+		var err error
+		if values, err = query.Values(params); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := cl.get(ctx, path, values)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, path)
+	}
+
+	var result T
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("tmdb: %s: decoding response: %w", path, err)
+	}
+
+	return &result, nil
+}
+
+func (cl *Client) get(ctx context.Context, path string, params url.Values) (res *http.Response, err error) {
 	endpoint, err := url.JoinPath(cl.baseURL, path)
 	if err != nil {
 		return nil, err
@@ -29,28 +115,141 @@ func (cl *Client) get(path string, params url.Values) (res *http.Response, err e
 		endpoint += "?" + params.Encode()
 	}
 
-	req, err := http.NewRequest("GET", endpoint, nil)
+	if cl.cache == nil {
+		if cl.Offline() {
+			return nil, ErrOffline
+		}
+
+		return cl.fetch(ctx, endpoint)
+	}
+
+	key := cacheKey(path, params)
+	cached, expires, hit := cl.cache.Get(key)
+	if hit && (cl.Offline() || time.Now().Before(expires)) {
+		return cachedResponse(cached), nil
+	}
+
+	if cl.Offline() {
+		return nil, ErrOffline
+	}
+
+	res, err = cl.fetch(ctx, endpoint)
+	if err != nil {
+		// Without a network, a stale response beats none
+		if hit && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
+			return cachedResponse(cached), nil
+		}
+
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		// Nor does TMDB being down or still rate limiting after the retries
+		if hit && (res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= http.StatusInternalServerError) {
+			io.Copy(io.Discard, io.LimitReader(res.Body, 64<<10))
+			res.Body.Close()
+			return cachedResponse(cached), nil
+		}
+
+		return res, nil
+	}
+
+	data, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	if ttl := cl.cacheTTL(path, data); ttl > 0 {
+		// Failing to cache shouldn't fail the request
+		cl.cache.Set(key, data, time.Now().Add(ttl))
+	}
+
+	res.Body = io.NopCloser(bytes.NewReader(data))
+	return res, nil
+}
+
+// fetch makes the request, waiting on the rate limiter and retrying as the retry policy allows
+func (cl *Client) fetch(ctx context.Context, endpoint string) (res *http.Response, err error) {
+	for attempt := 0; ; attempt++ {
+		if cl.limiter != nil {
+			if err := cl.limiter.wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		res, err = cl.do(ctx, endpoint)
+
+		wait, retry := cl.retry.retryDelay(attempt, res, err)
+		if !retry {
+			return res, err
+		}
+
+		if res != nil {
+			// Hold back every request sharing this client, not just this one
+			if res.StatusCode == http.StatusTooManyRequests && cl.limiter != nil {
+				cl.limiter.pause(wait)
+			}
+
+			// Drain the body so the connection can be reused
+			io.Copy(io.Discard, io.LimitReader(res.Body, 64<<10))
+			res.Body.Close()
+		}
+
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (cl *Client) do(ctx context.Context, endpoint string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
 
+	// Add credentials
+	switch cl.authMode {
+	case APIKeyAuth:
+		query := req.URL.Query()
+		query.Set("api_key", cl.key)
+		req.URL.RawQuery = query.Encode()
+	default:
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", cl.key))
+	}
+
 	// Add headers
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", cl.key))
 	req.Header.Set("Content-Type", "application/json")
+	if cl.userAgent != "" {
+		req.Header.Set("User-Agent", cl.userAgent)
+	}
+
+	for _, hook := range cl.onRequest {
+		hook(req)
+	}
 
 	// Make request
-	client := &http.Client{}
-	res, err = client.Do(req)
-	if err != nil {
-		return nil, err
+	start := time.Now()
+	res, err := cl.http.Do(req)
+	elapsed := time.Since(start)
+
+	// Don't leak the api_key query param in error messages
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		urlErr.URL = endpoint
+	}
+
+	for _, hook := range cl.onResponse {
+		hook(req, res, err, elapsed)
 	}
 
-	return
+	return res, err
 }
 
+// Episode group types, see EpisodeGroup.Type
 const (
-	DefaultGrouping = 0
-	AirDateGrouping = iota + 1
+	DefaultGrouping Grouping = iota // The series' own seasons, not an episode group
+	AirDateGrouping
 	AbsoluteGrouping
 	DVDGrouping
 	DigitalGrouping
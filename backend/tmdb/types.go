@@ -28,19 +28,20 @@ type TVSearchParams struct {
 }
 
 type Movie struct {
-	ID               int     `json:"id"`
-	Title            string  `json:"title"`
-	OriginalTitle    string  `json:"original_title"`
-	OriginalLanguage string  `json:"original_language"`
-	Overview         string  `json:"overview"`
-	PosterPath       *string `json:"poster_path"`
-	BackdropPath     *string `json:"backdrop_path"`
-	ReleaseDate      string  `json:"release_date"`
-	Adult            bool    `json:"adult"`
-	Popularity       float64 `json:"popularity"`
-	VoteAverage      float64 `json:"vote_average"`
-	VoteCount        int     `json:"vote_count"`
-	GenreIDs         []int   `json:"genre_ids"`
+	ID               int      `json:"id"`
+	Title            string   `json:"title"`
+	OriginalTitle    string   `json:"original_title"`
+	OriginalLanguage string   `json:"original_language"`
+	Overview         string   `json:"overview"`
+	PosterPath       *string  `json:"poster_path"`
+	BackdropPath     *string  `json:"backdrop_path"`
+	ReleaseDate      string   `json:"release_date"`
+	Adult            bool     `json:"adult"`
+	Popularity       float64  `json:"popularity"`
+	VoteAverage      float64  `json:"vote_average"`
+	VoteCount        int      `json:"vote_count"`
+	GenreIDs         []int    `json:"genre_ids"`
+	GenreNames       []string `json:"genre_names,omitempty"` // Filled in by EnrichMovies
 }
 
 type TVShow struct {
@@ -58,6 +59,7 @@ type TVShow struct {
 	VoteCount        int      `json:"vote_count"`
 	GenreIDs         []int    `json:"genre_ids"`
 	OriginCountry    []string `json:"origin_country"`
+	GenreNames       []string `json:"genre_names,omitempty"` // Filled in by EnrichTVShows
 }
 
 type Person struct {
@@ -110,6 +112,40 @@ func (m *MultiMedia) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (m MultiMedia) MarshalJSON() ([]byte, error) {
+	// Marshal only the embedded struct matching MediaType, so the result
+	// round-trips through UnmarshalJSON
+	var media any
+	switch m.MediaType {
+	case "movie":
+		media = m.Movie
+	case "tv":
+		media = m.TVShow
+	case "person":
+		media = m.Person
+	default:
+		media = struct{}{}
+	}
+
+	data, err := json.Marshal(media)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	mediaType, err := json.Marshal(m.MediaType)
+	if err != nil {
+		return nil, err
+	}
+	fields["media_type"] = mediaType
+
+	return json.Marshal(fields)
+}
+
 type SearchResponse[T any] struct {
 	Page         int `json:"page"`
 	Results      []T `json:"results"`
@@ -146,10 +182,14 @@ type SpokenLanguage struct {
 }
 
 type Collection struct {
-	ID           int     `json:"id"`
-	Name         string  `json:"name"`
-	PosterPath   *string `json:"poster_path"`
-	BackdropPath *string `json:"backdrop_path"`
+	ID               int     `json:"id"`
+	Name             string  `json:"name"`
+	PosterPath       *string `json:"poster_path"`
+	BackdropPath     *string `json:"backdrop_path"`
+	Adult            bool    `json:"adult,omitempty"`             // Search results only
+	OriginalLanguage string  `json:"original_language,omitempty"` // Search results only
+	OriginalName     string  `json:"original_name,omitempty"`     // Search results only
+	Overview         string  `json:"overview,omitempty"`          // Search results only
 }
 
 type MovieDetails struct {
@@ -181,9 +221,16 @@ type MovieDetails struct {
 	VoteCount           int                 `json:"vote_count"`
 
 	// Append response fields (populated by custom UnmarshalJSON)
-	Videos  *VideosResponse  `json:"videos,omitempty"`
-	Images  *ImagesResponse  `json:"images,omitempty"`
-	Credits *CreditsResponse `json:"credits,omitempty"`
+	Videos      *VideosResponse      `json:"videos,omitempty"`
+	Images      *ImagesResponse      `json:"images,omitempty"`
+	Credits     *CreditsResponse     `json:"credits,omitempty"`
+	ExternalIDs *ExternalIDsResponse `json:"external_ids,omitempty"`
+
+	AlternativeTitles *AlternativeTitlesResponse                  `json:"alternative_titles,omitempty"`
+	Translations      *TranslationsResponse[MovieTranslationData] `json:"translations,omitempty"`
+	ReleaseDates      *ReleaseDatesResponse                       `json:"release_dates,omitempty"`
+	Keywords          *KeywordsResponse                           `json:"keywords,omitempty"`
+	WatchProviders    *WatchProvidersResponse                     `json:"watch_providers,omitempty"` // From the watch/providers key
 }
 
 func (m *MovieDetails) UnmarshalJSON(data []byte) error {
@@ -199,6 +246,18 @@ func (m *MovieDetails) UnmarshalJSON(data []byte) error {
 	// Copy all fields
 	*m = MovieDetails(alias)
 
+	// Appended watch providers are keyed by their path, which can't be used as a field name
+	var raw struct {
+		WatchProviders *WatchProvidersResponse `json:"watch/providers"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if raw.WatchProviders != nil {
+		m.WatchProviders = raw.WatchProviders
+	}
+
 	return nil
 }
 
@@ -242,6 +301,30 @@ type Episode struct {
 	SeasonNumber   int     `json:"season_number"`
 	ShowID         int     `json:"show_id"`
 	StillPath      *string `json:"still_path"`
+	Order          int     `json:"order"` // Position within an episode group, only set there
+}
+
+type EpisodeDetails struct {
+	ID             int          `json:"id"`
+	Name           string       `json:"name"`
+	Overview       string       `json:"overview"`
+	VoteAverage    float64      `json:"vote_average"`
+	VoteCount      int          `json:"vote_count"`
+	AirDate        *string      `json:"air_date"`
+	EpisodeNumber  int          `json:"episode_number"`
+	EpisodeType    string       `json:"episode_type"`
+	ProductionCode *string      `json:"production_code"`
+	Runtime        *int         `json:"runtime"`
+	SeasonNumber   int          `json:"season_number"`
+	StillPath      *string      `json:"still_path"`
+	Crew           []CrewMember `json:"crew"`
+	GuestStars     []CastMember `json:"guest_stars"`
+
+	// Append response fields
+	Credits      *EpisodeCreditsResponse                       `json:"credits,omitempty"`
+	Images       *EpisodeImagesResponse                        `json:"images,omitempty"`
+	ExternalIDs  *ExternalIDsResponse                          `json:"external_ids,omitempty"`
+	Translations *TranslationsResponse[EpisodeTranslationData] `json:"translations,omitempty"`
 }
 
 type TVSeriesDetails struct {
@@ -279,11 +362,18 @@ type TVSeriesDetails struct {
 	VoteCount           int                 `json:"vote_count"`
 
 	// Append response fields (populated by custom UnmarshalJSON)
-	FullSeasons   []TVSeasonDetails `json:"-"` // From season/N keys
-	Videos        *VideosResponse   `json:"videos,omitempty"`
-	Images        *ImagesResponse   `json:"images,omitempty"`
-	Credits       *CreditsResponse  `json:"credits,omitempty"`
-	EpisodeGroups *EpisodeGroupList `json:"episode_groups,omitempty"`
+	FullSeasons   []TVSeasonDetails    `json:"-"` // From season/N keys
+	Videos        *VideosResponse      `json:"videos,omitempty"`
+	Images        *ImagesResponse      `json:"images,omitempty"`
+	Credits       *CreditsResponse     `json:"credits,omitempty"`
+	EpisodeGroups *EpisodeGroupList    `json:"episode_groups,omitempty"`
+	ExternalIDs   *ExternalIDsResponse `json:"external_ids,omitempty"`
+
+	AlternativeTitles *AlternativeTitlesResponse               `json:"alternative_titles,omitempty"`
+	Translations      *TranslationsResponse[TVTranslationData] `json:"translations,omitempty"`
+	ContentRatings    *ContentRatingsResponse                  `json:"content_ratings,omitempty"`
+	Keywords          *KeywordsResponse                        `json:"keywords,omitempty"`
+	WatchProviders    *WatchProvidersResponse                  `json:"watch_providers,omitempty"` // From the watch/providers key
 }
 
 func (t *TVSeriesDetails) UnmarshalJSON(data []byte) error {
@@ -305,6 +395,14 @@ func (t *TVSeriesDetails) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
+	// Appended watch providers are keyed by their path, which can't be used as a field name
+	if value, ok := raw["watch/providers"]; ok {
+		var providers WatchProvidersResponse
+		if err := json.Unmarshal(value, &providers); err == nil {
+			t.WatchProviders = &providers
+		}
+	}
+
 	// Extract season/N keys
 	seasonRegex := regexp.MustCompile(`^season/(\d+)$`)
 	var seasons []TVSeasonDetails
@@ -379,7 +477,7 @@ type EpisodeGroup struct {
 		Name          string  `json:"name"`
 		OriginCountry string  `json:"origin_country"`
 	} `json:"network"`
-	Type int `json:"type"`
+	Type Grouping `json:"type"`
 }
 
 type EpisodeGroupList struct {
@@ -397,13 +495,16 @@ type EpisodeGroupDetails struct {
 		Name          string  `json:"name"`
 		OriginCountry string  `json:"origin_country"`
 	} `json:"network"`
-	Type   int `json:"type"`
-	Groups []struct {
-		ID       string    `json:"id"`
-		Name     string    `json:"name"`
-		Order    int       `json:"order"`
-		Episodes []Episode `json:"episodes"`
-	} `json:"groups"`
+	Type   Grouping             `json:"type"`
+	Groups []EpisodeGroupSeason `json:"groups"`
+}
+
+// EpisodeGroupSeason is one group of an episode group, standing in for a season in its ordering
+type EpisodeGroupSeason struct {
+	ID       string    `json:"id"`
+	Name     string    `json:"name"`
+	Order    int       `json:"order"`
+	Episodes []Episode `json:"episodes"`
 }
 
 // Append response types for common TMDB append_to_response endpoints
@@ -473,3 +574,45 @@ type CreditsResponse struct {
 	Cast []CastMember `json:"cast"`
 	Crew []CrewMember `json:"crew"`
 }
+
+type EpisodeCreditsResponse struct {
+	Cast       []CastMember `json:"cast"`
+	Crew       []CrewMember `json:"crew"`
+	GuestStars []CastMember `json:"guest_stars"`
+}
+
+type EpisodeImagesResponse struct {
+	Stills []Image `json:"stills"`
+}
+
+// ExternalIDsResponse holds the IDs of a movie, series, season or episode on other sites,
+// each kind of media only has some of them
+type ExternalIDsResponse struct {
+	ID          int     `json:"id"`
+	IMDbID      *string `json:"imdb_id"`
+	TVDBID      *int    `json:"tvdb_id"`
+	TVRageID    *int    `json:"tvrage_id"`
+	WikidataID  *string `json:"wikidata_id"`
+	FreebaseMID *string `json:"freebase_mid"`
+	FreebaseID  *string `json:"freebase_id"`
+	FacebookID  *string `json:"facebook_id"`
+	InstagramID *string `json:"instagram_id"`
+	TwitterID   *string `json:"twitter_id"`
+}
+
+type Translation[D any] struct {
+	ISO3166_1   string `json:"iso_3166_1"`
+	ISO639_1    string `json:"iso_639_1"`
+	Name        string `json:"name"`
+	EnglishName string `json:"english_name"`
+	Data        D      `json:"data"`
+}
+
+type TranslationsResponse[D any] struct {
+	Translations []Translation[D] `json:"translations"`
+}
+
+type EpisodeTranslationData struct {
+	Name     string `json:"name"`
+	Overview string `json:"overview"`
+}
@@ -1,4 +1,5 @@
 import { computed, readonly, ref, shallowRef } from "vue"
+import { CancelLookups } from "../../wailsjs/go/main/App"
 import type { main } from "../../wailsjs/go/models"
 
 
@@ -61,6 +62,8 @@ export function useFiles() {
   // Clear all files
   const clearFiles = () => {
     filesMap.value.clear()
+    // Abandon any metadata lookups for the cleared files
+    CancelLookups()
   }
 
   // Reorder files
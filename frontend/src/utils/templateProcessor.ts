@@ -3,8 +3,16 @@ export interface MediaMetadata {
   year?: number
   season?: number
   episode?: number
+  episodes?: number[] // Multi episode files, eg. S01E01-E03, in file order
   episodeTitle?: string
+  episodeTitles?: string[] // One per entry of episodes
+  airDate?: string // YYYY-MM-DD, for daily shows named by date
+  absolute?: number // Counted across the whole series, as anime fansubs do
+  episodePart?: number // Of a split episode, eg. S01E10.Part.2
   seriesName?: string
+  collection?: string
+  part?: number // Position in the collection
+  certification?: string
   type?: "movie" | "tv" | "unknown"
 }
 
@@ -14,6 +22,23 @@ export interface FileTemplate {
   description: string
 }
 
+// Every variable a template can use, see TemplateProcessor.validateTemplate
+const templateVariables = [
+  "title",
+  "year",
+  "season",
+  "episode",
+  "episode_title",
+  "episode_part",
+  "collection",
+  "part",
+  "certification",
+  "air_date",
+  "absolute",
+  "#",
+  "##",
+]
+
 export const defaultTemplates: FileTemplate[] = [
   {
     name: "TV Show Default",
@@ -25,6 +50,21 @@ export const defaultTemplates: FileTemplate[] = [
     pattern: "[title] ([year])",
     description: "Standard movie format with title and year",
   },
+  {
+    name: "Movie Collection",
+    pattern: "[collection] [part] - [title] ([year])",
+    description: "Movies named after their collection, numbered in release order",
+  },
+  {
+    name: "Daily Show",
+    pattern: "[title] - [air_date] - [episode_title]",
+    description: "Talk shows and news named by the date they aired",
+  },
+  {
+    name: "Anime",
+    pattern: "[title] - S[##]E[##] - [absolute] - [episode_title]",
+    description: "Anime with both season and absolute episode numbers",
+  },
   {
     name: "TV Show Extended",
     pattern: "[title] ([year]) - S[##]E[##] - [episode_title]",
@@ -32,22 +72,68 @@ export const defaultTemplates: FileTemplate[] = [
   },
 ]
 
+// The episodes of a file, from episodes or the single episode
+export function episodeNumbers(metadata: MediaMetadata): number[] {
+  if (metadata.episodes?.length) {
+    return metadata.episodes
+  }
+
+  return metadata.episode !== undefined ? [metadata.episode] : []
+}
+
+// Formats episode numbers as a range when they run on, eg. E01-E03, otherwise in turn, eg. E01E03
+export function formatEpisodes(episodes: number[], prefix = "E", pad = 2, separator = ""): string {
+  const format = (episode: number) => `${prefix}${episode.toString().padStart(pad, "0")}`
+  const consecutive = episodes.every((episode, i) => i === 0 || episode === episodes[i - 1] + 1)
+
+  if (episodes.length > 2 && consecutive) {
+    return `${format(episodes[0])}-${format(episodes[episodes.length - 1])}`
+  }
+
+  return episodes.map(format).join(separator)
+}
+
+// Joins the titles of a multi episode file, split episodes like "Pilot (1)" and "Pilot (2)" become "Pilot"
+export function joinEpisodeTitles(titles: string[]): string {
+  const base = (title: string) =>
+    title.replace(/[\s,:-]*(\((?:part\s*)?\d+\)|part\s*\d+)$/i, "").trim()
+  const unique = [...new Set(titles.filter(Boolean))]
+  const bases = [...new Set(unique.map(base))]
+
+  if (unique.length > 1 && bases.length === 1) {
+    return bases[0]
+  }
+
+  return unique.join(" & ")
+}
+
+// The episode title of a file, joined for multi episode files
+export function formatEpisodeTitle(metadata: MediaMetadata): string | undefined {
+  if (metadata.episodeTitles?.length) {
+    return joinEpisodeTitles(metadata.episodeTitles)
+  }
+
+  return metadata.episodeTitle
+}
+
 export class MetadataFormatter {
   static formatMetadataDisplay(metadata: MediaMetadata): string {
     if (metadata.type === "tv") {
-      // TV format: "01|02, Show name, Episode title"
+      // TV format: "01|02, Show name, Episode title" or "01|02-04, ..." for multi episode files
       const season = metadata.season?.toString().padStart(2, "0") || "??"
-      const episode = metadata.episode?.toString().padStart(2, "0") || "??"
+      const episodes = episodeNumbers(metadata)
+      const episode = episodes.length ? formatEpisodes(episodes, "", 2, "+") : "??"
       const showName = metadata.title || "Unknown Show"
-      const episodeTitle = metadata.episodeTitle || "Unknown Episode"
+      const episodeTitle = formatEpisodeTitle(metadata) || "Unknown Episode"
 
       return `${season}|${episode}, ${showName}, ${episodeTitle}`
     } else if (metadata.type === "movie") {
       // Movie format: "Series name, Movie name" or just "Movie name"
       const movieName = metadata.title || "Unknown Movie"
+      const seriesName = metadata.collection || metadata.seriesName
 
-      if (metadata.seriesName) {
-        return `${metadata.seriesName}, ${movieName}`
+      if (seriesName) {
+        return `${seriesName}, ${movieName}`
       }
 
       return movieName
@@ -73,23 +159,41 @@ export class TemplateProcessor {
       // Replace template variables
       result = result.replace(/\[title\]/g, metadata.title || "Unknown")
       result = result.replace(/\[year\]/g, metadata.year?.toString() || "Unknown")
+      result = result.replace(
+        /\[collection\]/g,
+        metadata.collection || metadata.seriesName || metadata.title || "Unknown",
+      )
+      result = result.replace(/\[certification\]/g, metadata.certification || "NR")
+      result = result.replace(/\[air_date\]/g, metadata.airDate || "Unknown")
 
-      // Handle season/episode formatting
+      // Numbers are left unresolved when missing, a made up 00 would give a wrong name without a warning
+      if (metadata.absolute !== undefined) {
+        result = result.replace(/\[absolute\]/g, metadata.absolute.toString().padStart(2, "0"))
+      }
+      if (metadata.part !== undefined) {
+        result = result.replace(/\[part\]/g, metadata.part.toString().padStart(2, "0"))
+      }
+
+      // Handle season/episode formatting, E[##] is left for the episodes
       if (metadata.season !== undefined) {
-        result = result.replace(/\[##\]/g, metadata.season.toString().padStart(2, "0"))
-        result = result.replace(/\[#\]/g, metadata.season.toString())
+        result = result.replace(/(?<!E)\[##\]/g, metadata.season.toString().padStart(2, "0"))
+        result = result.replace(/(?<!E)\[#\]/g, metadata.season.toString())
       }
 
-      if (metadata.episode !== undefined) {
+      const episodes = episodeNumbers(metadata)
+      if (episodes.length) {
         const episodeMatch = result.match(/S\d+E\[##?\]/)
         if (episodeMatch) {
-          result = result.replace(/E\[##\]/g, `E${metadata.episode.toString().padStart(2, "0")}`)
-          result = result.replace(/E\[#\]/g, `E${metadata.episode.toString()}`)
+          result = result.replace(/E\[##\]/g, formatEpisodes(episodes))
+          result = result.replace(/E\[#\]/g, formatEpisodes(episodes, "E", 1))
         }
       }
 
+      result = result.replace(/\[episode_title\]/g, formatEpisodeTitle(metadata) || "")
+      result = result.replace(/\[episode_part\]/g, metadata.episodePart ? `Part ${metadata.episodePart}` : "")
+
       // Clean up any remaining template variables
-      result = result.replace(/\[episode_title\]/g, "")
+      result = result.replace(/\s+-\s*$/, "")
       result = result.replace(/\s+/g, " ").trim()
 
       // Add extension
@@ -108,12 +212,21 @@ export class TemplateProcessor {
 
       if (
         template.includes("[##]") &&
-        (metadata.season === undefined || metadata.episode === undefined)
+        (metadata.season === undefined || episodes.length === 0)
       ) {
         status = "warning"
         message = "Missing season/episode information"
       }
 
+      // Season and episode are checked above
+      const unresolved = TemplateProcessor.parseTemplate(result).filter(
+        (v) => templateVariables.includes(v) && !v.startsWith("#"),
+      )
+      if (unresolved.length) {
+        status = "warning"
+        message = `Missing ${unresolved.map((v) => `[${v}]`).join(", ")}`
+      }
+
       return { newFilename: result, status, message }
     } catch (error) {
       return {
@@ -140,12 +253,16 @@ export class TemplateProcessor {
       errors.push("Unmatched brackets in template")
     }
 
+    // Templates name a file in place, they can't move it into a folder
+    if (/[\/\\]/.test(template)) {
+      errors.push("Templates can't contain / or \\, they name files rather than folders")
+    }
+
     // Check for valid variable names
-    const validVariables = ["title", "year", "season", "episode", "episode_title", "#", "##"]
     const variables = TemplateProcessor.parseTemplate(template)
 
     for (const variable of variables) {
-      if (!validVariables.includes(variable)) {
+      if (!templateVariables.includes(variable)) {
         errors.push(`Unknown template variable: [${variable}]`)
       }
     }
@@ -1,9 +1,107 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
+import {tmdb} from '../models';
 import {main} from '../models';
+import {release} from '../models';
+
+export function ArtworkURL(arg1:string,arg2:tmdb.ImageKind,arg3:number):Promise<string>;
+
+export function CancelLookups():Promise<void>;
+
+export function Collection(arg1:number,arg2:tmdb.CollectionParams):Promise<tmdb.CollectionDetails>;
+
+export function Countries(arg1:string):Promise<Array<tmdb.Country>>;
+
+export function DiscoverMovie(arg1:tmdb.DiscoverMovieParams):Promise<tmdb.SearchResponse_mediajerk_backend_tmdb_Movie_>;
+
+export function DiscoverTV(arg1:tmdb.DiscoverTVParams):Promise<tmdb.SearchResponse_mediajerk_backend_tmdb_TVShow_>;
+
+export function EpisodeGroups(arg1:number):Promise<tmdb.EpisodeGroupList>;
+
+export function EpisodeOrdering(arg1:number):Promise<string>;
+
+export function EpisodeOrderings(arg1:number):Promise<Array<string>>;
+
+export function EpisodesGroupedBy(arg1:string):Promise<tmdb.EpisodeGroupDetails>;
 
 export function FilepathJoin(arg1:Array<string>):Promise<string>;
 
+export function FindByExternalID(arg1:string,arg2:tmdb.ExternalSource):Promise<tmdb.FindResponse>;
+
 export function Greet(arg1:string):Promise<string>;
 
+export function ImageURL(arg1:string,arg2:tmdb.ImageKind,arg3:number):Promise<string>;
+
+export function Languages():Promise<Array<tmdb.SpokenLanguage>>;
+
+export function Movie(arg1:number,arg2:tmdb.DetailsParams):Promise<tmdb.MovieDetails>;
+
+export function MovieAlternativeTitles(arg1:number,arg2:string):Promise<tmdb.AlternativeTitlesResponse>;
+
+export function MovieCertification(arg1:number,arg2:string):Promise<string>;
+
+export function MovieCollection(arg1:number,arg2:string):Promise<main.MovieCollection>;
+
+export function MovieExternalIDs(arg1:number):Promise<tmdb.ExternalIDsResponse>;
+
+export function MovieGenres(arg1:string):Promise<Array<tmdb.Genre>>;
+
+export function MovieKeywords(arg1:number):Promise<tmdb.KeywordsResponse>;
+
+export function MovieTranslations(arg1:number):Promise<tmdb.TranslationsResponse_mediajerk_backend_tmdb_MovieTranslationData_>;
+
+export function MovieWatchProviders(arg1:number,arg2:string):Promise<tmdb.CountryWatchProviders>;
+
+export function ParseRelease(arg1:string):Promise<release.Info>;
+
+export function Person(arg1:number,arg2:tmdb.DetailsParams):Promise<tmdb.PersonDetails>;
+
+export function PersonCredits(arg1:number,arg2:string,arg3:string):Promise<Array<tmdb.PersonCredit>>;
+
+export function SaveMovieArtwork(arg1:number,arg2:string):Promise<Array<string>>;
+
+export function SaveSeriesArtwork(arg1:number,arg2:string):Promise<Array<string>>;
+
+export function SearchCollection(arg1:tmdb.CollectionSearchParams):Promise<tmdb.SearchResponse_mediajerk_backend_tmdb_Collection_>;
+
+export function SearchMovie(arg1:tmdb.MovieSearchParams):Promise<tmdb.SearchResponse_mediajerk_backend_tmdb_Movie_>;
+
+export function SearchMulti(arg1:tmdb.CommonSearchParams):Promise<tmdb.SearchResponse_mediajerk_backend_tmdb_MultiMedia_>;
+
+export function SearchPerson(arg1:tmdb.CommonSearchParams):Promise<tmdb.SearchResponse_mediajerk_backend_tmdb_Person_>;
+
+export function SearchTV(arg1:tmdb.TVSearchParams):Promise<tmdb.SearchResponse_mediajerk_backend_tmdb_TVShow_>;
+
 export function SelectFiles(arg1:main.FileDialogOptions):Promise<Array<main.FileInfo>>;
+
+export function SetEpisodeOrdering(arg1:number,arg2:string):Promise<void>;
+
+export function SetOffline(arg1:boolean):Promise<void>;
+
+export function TVEpisode(arg1:number,arg2:number,arg3:number,arg4:tmdb.DetailsParams):Promise<tmdb.EpisodeDetails>;
+
+export function TVEpisodeByAbsolute(arg1:number,arg2:number):Promise<tmdb.Episode>;
+
+export function TVEpisodes(arg1:number,arg2:number,arg3:Array<number>,arg4:tmdb.DetailsParams):Promise<Array<tmdb.Episode>>;
+
+export function TVEpisodesByAirDate(arg1:number,arg2:string):Promise<Array<tmdb.Episode>>;
+
+export function TVGenres(arg1:string):Promise<Array<tmdb.Genre>>;
+
+export function TVSeason(arg1:number,arg2:number,arg3:tmdb.DetailsParams):Promise<tmdb.TVSeasonDetails>;
+
+export function TVSeries(arg1:number,arg2:tmdb.DetailsParams):Promise<tmdb.TVSeriesDetails>;
+
+export function TVSeriesAlternativeTitles(arg1:number):Promise<tmdb.AlternativeTitlesResponse>;
+
+export function TVSeriesContentRating(arg1:number,arg2:string):Promise<string>;
+
+export function TVSeriesExternalIDs(arg1:number):Promise<tmdb.ExternalIDsResponse>;
+
+export function TVSeriesKeywords(arg1:number):Promise<tmdb.KeywordsResponse>;
+
+export function TVSeriesTranslations(arg1:number):Promise<tmdb.TranslationsResponse_mediajerk_backend_tmdb_TVTranslationData_>;
+
+export function TVSeriesWatchProviders(arg1:number,arg2:string):Promise<tmdb.CountryWatchProviders>;
+
+export function Trending(arg1:tmdb.TrendingMediaType,arg2:tmdb.TimeWindow,arg3:tmdb.TrendingParams):Promise<tmdb.SearchResponse_mediajerk_backend_tmdb_MultiMedia_>;
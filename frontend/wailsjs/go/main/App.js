@@ -2,14 +2,206 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 
+export function ArtworkURL(arg1, arg2, arg3) {
+  return window['go']['main']['App']['ArtworkURL'](arg1, arg2, arg3);
+}
+
+export function CancelLookups() {
+  return window['go']['main']['App']['CancelLookups']();
+}
+
+export function Collection(arg1, arg2) {
+  return window['go']['main']['App']['Collection'](arg1, arg2);
+}
+
+export function Countries(arg1) {
+  return window['go']['main']['App']['Countries'](arg1);
+}
+
+export function DiscoverMovie(arg1) {
+  return window['go']['main']['App']['DiscoverMovie'](arg1);
+}
+
+export function DiscoverTV(arg1) {
+  return window['go']['main']['App']['DiscoverTV'](arg1);
+}
+
+export function EpisodeGroups(arg1) {
+  return window['go']['main']['App']['EpisodeGroups'](arg1);
+}
+
+export function EpisodeOrdering(arg1) {
+  return window['go']['main']['App']['EpisodeOrdering'](arg1);
+}
+
+export function EpisodeOrderings(arg1) {
+  return window['go']['main']['App']['EpisodeOrderings'](arg1);
+}
+
+export function EpisodesGroupedBy(arg1) {
+  return window['go']['main']['App']['EpisodesGroupedBy'](arg1);
+}
+
 export function FilepathJoin(arg1) {
   return window['go']['main']['App']['FilepathJoin'](arg1);
 }
 
+export function FindByExternalID(arg1, arg2) {
+  return window['go']['main']['App']['FindByExternalID'](arg1, arg2);
+}
+
 export function Greet(arg1) {
   return window['go']['main']['App']['Greet'](arg1);
 }
 
+export function ImageURL(arg1, arg2, arg3) {
+  return window['go']['main']['App']['ImageURL'](arg1, arg2, arg3);
+}
+
+export function Languages() {
+  return window['go']['main']['App']['Languages']();
+}
+
+export function Movie(arg1, arg2) {
+  return window['go']['main']['App']['Movie'](arg1, arg2);
+}
+
+export function MovieAlternativeTitles(arg1, arg2) {
+  return window['go']['main']['App']['MovieAlternativeTitles'](arg1, arg2);
+}
+
+export function MovieCertification(arg1, arg2) {
+  return window['go']['main']['App']['MovieCertification'](arg1, arg2);
+}
+
+export function MovieCollection(arg1, arg2) {
+  return window['go']['main']['App']['MovieCollection'](arg1, arg2);
+}
+
+export function MovieExternalIDs(arg1) {
+  return window['go']['main']['App']['MovieExternalIDs'](arg1);
+}
+
+export function MovieGenres(arg1) {
+  return window['go']['main']['App']['MovieGenres'](arg1);
+}
+
+export function MovieKeywords(arg1) {
+  return window['go']['main']['App']['MovieKeywords'](arg1);
+}
+
+export function MovieTranslations(arg1) {
+  return window['go']['main']['App']['MovieTranslations'](arg1);
+}
+
+export function MovieWatchProviders(arg1, arg2) {
+  return window['go']['main']['App']['MovieWatchProviders'](arg1, arg2);
+}
+
+export function ParseRelease(arg1) {
+  return window['go']['main']['App']['ParseRelease'](arg1);
+}
+
+export function Person(arg1, arg2) {
+  return window['go']['main']['App']['Person'](arg1, arg2);
+}
+
+export function PersonCredits(arg1, arg2, arg3) {
+  return window['go']['main']['App']['PersonCredits'](arg1, arg2, arg3);
+}
+
+export function SaveMovieArtwork(arg1, arg2) {
+  return window['go']['main']['App']['SaveMovieArtwork'](arg1, arg2);
+}
+
+export function SaveSeriesArtwork(arg1, arg2) {
+  return window['go']['main']['App']['SaveSeriesArtwork'](arg1, arg2);
+}
+
+export function SearchCollection(arg1) {
+  return window['go']['main']['App']['SearchCollection'](arg1);
+}
+
+export function SearchMovie(arg1) {
+  return window['go']['main']['App']['SearchMovie'](arg1);
+}
+
+export function SearchMulti(arg1) {
+  return window['go']['main']['App']['SearchMulti'](arg1);
+}
+
+export function SearchPerson(arg1) {
+  return window['go']['main']['App']['SearchPerson'](arg1);
+}
+
+export function SearchTV(arg1) {
+  return window['go']['main']['App']['SearchTV'](arg1);
+}
+
 export function SelectFiles(arg1) {
   return window['go']['main']['App']['SelectFiles'](arg1);
 }
+
+export function SetEpisodeOrdering(arg1, arg2) {
+  return window['go']['main']['App']['SetEpisodeOrdering'](arg1, arg2);
+}
+
+export function SetOffline(arg1) {
+  return window['go']['main']['App']['SetOffline'](arg1);
+}
+
+export function TVEpisode(arg1, arg2, arg3, arg4) {
+  return window['go']['main']['App']['TVEpisode'](arg1, arg2, arg3, arg4);
+}
+
+export function TVEpisodeByAbsolute(arg1, arg2) {
+  return window['go']['main']['App']['TVEpisodeByAbsolute'](arg1, arg2);
+}
+
+export function TVEpisodes(arg1, arg2, arg3, arg4) {
+  return window['go']['main']['App']['TVEpisodes'](arg1, arg2, arg3, arg4);
+}
+
+export function TVEpisodesByAirDate(arg1, arg2) {
+  return window['go']['main']['App']['TVEpisodesByAirDate'](arg1, arg2);
+}
+
+export function TVGenres(arg1) {
+  return window['go']['main']['App']['TVGenres'](arg1);
+}
+
+export function TVSeason(arg1, arg2, arg3) {
+  return window['go']['main']['App']['TVSeason'](arg1, arg2, arg3);
+}
+
+export function TVSeries(arg1, arg2) {
+  return window['go']['main']['App']['TVSeries'](arg1, arg2);
+}
+
+export function TVSeriesAlternativeTitles(arg1) {
+  return window['go']['main']['App']['TVSeriesAlternativeTitles'](arg1);
+}
+
+export function TVSeriesContentRating(arg1, arg2) {
+  return window['go']['main']['App']['TVSeriesContentRating'](arg1, arg2);
+}
+
+export function TVSeriesExternalIDs(arg1) {
+  return window['go']['main']['App']['TVSeriesExternalIDs'](arg1);
+}
+
+export function TVSeriesKeywords(arg1) {
+  return window['go']['main']['App']['TVSeriesKeywords'](arg1);
+}
+
+export function TVSeriesTranslations(arg1) {
+  return window['go']['main']['App']['TVSeriesTranslations'](arg1);
+}
+
+export function TVSeriesWatchProviders(arg1, arg2) {
+  return window['go']['main']['App']['TVSeriesWatchProviders'](arg1, arg2);
+}
+
+export function Trending(arg1, arg2, arg3) {
+  return window['go']['main']['App']['Trending'](arg1, arg2, arg3);
+}
@@ -71,6 +71,2584 @@ export namespace main {
 	        this.lastModified = source["lastModified"];
 	    }
 	}
+	export class MovieCollection {
+	    collection?: tmdb.CollectionDetails;
+	    part: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new MovieCollection(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.collection = this.convertValues(source["collection"], tmdb.CollectionDetails);
+	        this.part = source["part"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace release {
+	
+	export class Info {
+	    title: string;
+	    year?: number;
+	    season?: number;
+	    episodes?: number[];
+	    episodePart?: number;
+	    episodeTitle?: string;
+	    airDate?: string;
+	    absolute?: number;
+	    version?: number;
+	    crc32?: string;
+	    resolution?: string;
+	    source?: string;
+	    videoCodec?: string;
+	    audioCodec?: string;
+	    audioChannels?: string;
+	    group?: string;
+	    edition?: string;
+	    languages?: string[];
+	    type: string;
+	    confidence: Record<string, number>;
+	
+	    static createFrom(source: any = {}) {
+	        return new Info(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.title = source["title"];
+	        this.year = source["year"];
+	        this.season = source["season"];
+	        this.episodes = source["episodes"];
+	        this.episodePart = source["episodePart"];
+	        this.episodeTitle = source["episodeTitle"];
+	        this.airDate = source["airDate"];
+	        this.absolute = source["absolute"];
+	        this.version = source["version"];
+	        this.crc32 = source["crc32"];
+	        this.resolution = source["resolution"];
+	        this.source = source["source"];
+	        this.videoCodec = source["videoCodec"];
+	        this.audioCodec = source["audioCodec"];
+	        this.audioChannels = source["audioChannels"];
+	        this.group = source["group"];
+	        this.edition = source["edition"];
+	        this.languages = source["languages"];
+	        this.type = source["type"];
+	        this.confidence = source["confidence"];
+	    }
+	}
+
+}
+
+export namespace tmdb {
+	
+	export class AlternativeTitle {
+	    iso_3166_1: string;
+	    title: string;
+	    type: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new AlternativeTitle(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.iso_3166_1 = source["iso_3166_1"];
+	        this.title = source["title"];
+	        this.type = source["type"];
+	    }
+	}
+	export class AlternativeTitlesResponse {
+	    titles: AlternativeTitle[];
+	
+	    static createFrom(source: any = {}) {
+	        return new AlternativeTitlesResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.titles = this.convertValues(source["titles"], AlternativeTitle);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CastMember {
+	    adult: boolean;
+	    gender: number;
+	    id: number;
+	    known_for_department: string;
+	    name: string;
+	    original_name: string;
+	    popularity: number;
+	    profile_path?: string;
+	    cast_id: number;
+	    character: string;
+	    credit_id: string;
+	    order: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new CastMember(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.adult = source["adult"];
+	        this.gender = source["gender"];
+	        this.id = source["id"];
+	        this.known_for_department = source["known_for_department"];
+	        this.name = source["name"];
+	        this.original_name = source["original_name"];
+	        this.popularity = source["popularity"];
+	        this.profile_path = source["profile_path"];
+	        this.cast_id = source["cast_id"];
+	        this.character = source["character"];
+	        this.credit_id = source["credit_id"];
+	        this.order = source["order"];
+	    }
+	}
+	export class Collection {
+	    id: number;
+	    name: string;
+	    poster_path?: string;
+	    backdrop_path?: string;
+	    adult?: boolean;
+	    original_language?: string;
+	    original_name?: string;
+	    overview?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Collection(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.poster_path = source["poster_path"];
+	        this.backdrop_path = source["backdrop_path"];
+	        this.adult = source["adult"];
+	        this.original_language = source["original_language"];
+	        this.original_name = source["original_name"];
+	        this.overview = source["overview"];
+	    }
+	}
+	export class Movie {
+	    id: number;
+	    title: string;
+	    original_title: string;
+	    original_language: string;
+	    overview: string;
+	    poster_path?: string;
+	    backdrop_path?: string;
+	    release_date: string;
+	    adult: boolean;
+	    popularity: number;
+	    vote_average: number;
+	    vote_count: number;
+	    genre_ids: number[];
+	    genre_names?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new Movie(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.title = source["title"];
+	        this.original_title = source["original_title"];
+	        this.original_language = source["original_language"];
+	        this.overview = source["overview"];
+	        this.poster_path = source["poster_path"];
+	        this.backdrop_path = source["backdrop_path"];
+	        this.release_date = source["release_date"];
+	        this.adult = source["adult"];
+	        this.popularity = source["popularity"];
+	        this.vote_average = source["vote_average"];
+	        this.vote_count = source["vote_count"];
+	        this.genre_ids = source["genre_ids"];
+	        this.genre_names = source["genre_names"];
+	    }
+	}
+	export class CollectionDetails {
+	    id: number;
+	    name: string;
+	    overview: string;
+	    poster_path?: string;
+	    backdrop_path?: string;
+	    parts: Movie[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CollectionDetails(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.overview = source["overview"];
+	        this.poster_path = source["poster_path"];
+	        this.backdrop_path = source["backdrop_path"];
+	        this.parts = this.convertValues(source["parts"], Movie);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CollectionParams {
+	    Language: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CollectionParams(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Language = source["Language"];
+	    }
+	}
+	export class CollectionSearchParams {
+	    Query: string;
+	    IncludeAdult: boolean;
+	    Language: string;
+	    Page: number;
+	    Region: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CollectionSearchParams(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Query = source["Query"];
+	        this.IncludeAdult = source["IncludeAdult"];
+	        this.Language = source["Language"];
+	        this.Page = source["Page"];
+	        this.Region = source["Region"];
+	    }
+	}
+	export class PersonCredit {
+	    media_type: string;
+	    id: number;
+	    title?: string;
+	    original_title?: string;
+	    release_date?: string;
+	    name?: string;
+	    original_name?: string;
+	    first_air_date?: string;
+	    original_language: string;
+	    overview: string;
+	    poster_path?: string;
+	    backdrop_path?: string;
+	    genre_ids: number[];
+	    adult: boolean;
+	    popularity: number;
+	    vote_average: number;
+	    vote_count: number;
+	    credit_id: string;
+	    character?: string;
+	    order?: number;
+	    episode_count?: number;
+	    department?: string;
+	    job?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new PersonCredit(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.media_type = source["media_type"];
+	        this.id = source["id"];
+	        this.title = source["title"];
+	        this.original_title = source["original_title"];
+	        this.release_date = source["release_date"];
+	        this.name = source["name"];
+	        this.original_name = source["original_name"];
+	        this.first_air_date = source["first_air_date"];
+	        this.original_language = source["original_language"];
+	        this.overview = source["overview"];
+	        this.poster_path = source["poster_path"];
+	        this.backdrop_path = source["backdrop_path"];
+	        this.genre_ids = source["genre_ids"];
+	        this.adult = source["adult"];
+	        this.popularity = source["popularity"];
+	        this.vote_average = source["vote_average"];
+	        this.vote_count = source["vote_count"];
+	        this.credit_id = source["credit_id"];
+	        this.character = source["character"];
+	        this.order = source["order"];
+	        this.episode_count = source["episode_count"];
+	        this.department = source["department"];
+	        this.job = source["job"];
+	    }
+	}
+	export class CombinedCreditsResponse {
+	    cast: PersonCredit[];
+	    crew: PersonCredit[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CombinedCreditsResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.cast = this.convertValues(source["cast"], PersonCredit);
+	        this.crew = this.convertValues(source["crew"], PersonCredit);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CommonSearchParams {
+	    Query: string;
+	    IncludeAdult: boolean;
+	    Language: string;
+	    Page: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new CommonSearchParams(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Query = source["Query"];
+	        this.IncludeAdult = source["IncludeAdult"];
+	        this.Language = source["Language"];
+	        this.Page = source["Page"];
+	    }
+	}
+	export class ContentRating {
+	    descriptors: string[];
+	    iso_3166_1: string;
+	    rating: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ContentRating(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.descriptors = source["descriptors"];
+	        this.iso_3166_1 = source["iso_3166_1"];
+	        this.rating = source["rating"];
+	    }
+	}
+	export class ContentRatingsResponse {
+	    results: ContentRating[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ContentRatingsResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.results = this.convertValues(source["results"], ContentRating);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Country {
+	    iso_3166_1: string;
+	    english_name: string;
+	    native_name: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Country(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.iso_3166_1 = source["iso_3166_1"];
+	        this.english_name = source["english_name"];
+	        this.native_name = source["native_name"];
+	    }
+	}
+	export class ReleaseDate {
+	    certification: string;
+	    descriptors: string[];
+	    iso_639_1: string;
+	    note: string;
+	    release_date: string;
+	    type: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ReleaseDate(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.certification = source["certification"];
+	        this.descriptors = source["descriptors"];
+	        this.iso_639_1 = source["iso_639_1"];
+	        this.note = source["note"];
+	        this.release_date = source["release_date"];
+	        this.type = source["type"];
+	    }
+	}
+	export class CountryReleaseDates {
+	    iso_3166_1: string;
+	    release_dates: ReleaseDate[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CountryReleaseDates(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.iso_3166_1 = source["iso_3166_1"];
+	        this.release_dates = this.convertValues(source["release_dates"], ReleaseDate);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class WatchProvider {
+	    display_priority: number;
+	    logo_path?: string;
+	    provider_id: number;
+	    provider_name: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new WatchProvider(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.display_priority = source["display_priority"];
+	        this.logo_path = source["logo_path"];
+	        this.provider_id = source["provider_id"];
+	        this.provider_name = source["provider_name"];
+	    }
+	}
+	export class CountryWatchProviders {
+	    link: string;
+	    flatrate: WatchProvider[];
+	    free: WatchProvider[];
+	    ads: WatchProvider[];
+	    rent: WatchProvider[];
+	    buy: WatchProvider[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CountryWatchProviders(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.link = source["link"];
+	        this.flatrate = this.convertValues(source["flatrate"], WatchProvider);
+	        this.free = this.convertValues(source["free"], WatchProvider);
+	        this.ads = this.convertValues(source["ads"], WatchProvider);
+	        this.rent = this.convertValues(source["rent"], WatchProvider);
+	        this.buy = this.convertValues(source["buy"], WatchProvider);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CreatedBy {
+	    id: number;
+	    credit_id: string;
+	    name: string;
+	    gender: number;
+	    profile_path?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CreatedBy(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.credit_id = source["credit_id"];
+	        this.name = source["name"];
+	        this.gender = source["gender"];
+	        this.profile_path = source["profile_path"];
+	    }
+	}
+	export class CrewMember {
+	    adult: boolean;
+	    gender: number;
+	    id: number;
+	    known_for_department: string;
+	    name: string;
+	    original_name: string;
+	    popularity: number;
+	    profile_path?: string;
+	    credit_id: string;
+	    department: string;
+	    job: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CrewMember(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.adult = source["adult"];
+	        this.gender = source["gender"];
+	        this.id = source["id"];
+	        this.known_for_department = source["known_for_department"];
+	        this.name = source["name"];
+	        this.original_name = source["original_name"];
+	        this.popularity = source["popularity"];
+	        this.profile_path = source["profile_path"];
+	        this.credit_id = source["credit_id"];
+	        this.department = source["department"];
+	        this.job = source["job"];
+	    }
+	}
+	export class CreditsResponse {
+	    cast: CastMember[];
+	    crew: CrewMember[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CreditsResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.cast = this.convertValues(source["cast"], CastMember);
+	        this.crew = this.convertValues(source["crew"], CrewMember);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class DetailsParams {
+	    Language: string;
+	    AppendToResponse: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new DetailsParams(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Language = source["Language"];
+	        this.AppendToResponse = source["AppendToResponse"];
+	    }
+	}
+	export class DiscoverMovieParams {
+	    Language: string;
+	    Region: string;
+	    Page: number;
+	    SortBy: string;
+	    IncludeAdult: boolean;
+	    IncludeVideo: boolean;
+	    Year: number;
+	    PrimaryReleaseYear: number;
+	    PrimaryReleaseDateGTE: string;
+	    PrimaryReleaseDateLTE: string;
+	    ReleaseDateGTE: string;
+	    ReleaseDateLTE: string;
+	    WithReleaseType: string;
+	    Certification: string;
+	    CertificationCountry: string;
+	    WithGenres: string;
+	    WithoutGenres: string;
+	    WithKeywords: string;
+	    WithCompanies: string;
+	    WithCast: string;
+	    WithCrew: string;
+	    WithOriginalLanguage: string;
+	    WithOriginCountry: string;
+	    WithRuntimeGTE: number;
+	    WithRuntimeLTE: number;
+	    VoteAverageGTE: number;
+	    VoteCountGTE: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new DiscoverMovieParams(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Language = source["Language"];
+	        this.Region = source["Region"];
+	        this.Page = source["Page"];
+	        this.SortBy = source["SortBy"];
+	        this.IncludeAdult = source["IncludeAdult"];
+	        this.IncludeVideo = source["IncludeVideo"];
+	        this.Year = source["Year"];
+	        this.PrimaryReleaseYear = source["PrimaryReleaseYear"];
+	        this.PrimaryReleaseDateGTE = source["PrimaryReleaseDateGTE"];
+	        this.PrimaryReleaseDateLTE = source["PrimaryReleaseDateLTE"];
+	        this.ReleaseDateGTE = source["ReleaseDateGTE"];
+	        this.ReleaseDateLTE = source["ReleaseDateLTE"];
+	        this.WithReleaseType = source["WithReleaseType"];
+	        this.Certification = source["Certification"];
+	        this.CertificationCountry = source["CertificationCountry"];
+	        this.WithGenres = source["WithGenres"];
+	        this.WithoutGenres = source["WithoutGenres"];
+	        this.WithKeywords = source["WithKeywords"];
+	        this.WithCompanies = source["WithCompanies"];
+	        this.WithCast = source["WithCast"];
+	        this.WithCrew = source["WithCrew"];
+	        this.WithOriginalLanguage = source["WithOriginalLanguage"];
+	        this.WithOriginCountry = source["WithOriginCountry"];
+	        this.WithRuntimeGTE = source["WithRuntimeGTE"];
+	        this.WithRuntimeLTE = source["WithRuntimeLTE"];
+	        this.VoteAverageGTE = source["VoteAverageGTE"];
+	        this.VoteCountGTE = source["VoteCountGTE"];
+	    }
+	}
+	export class DiscoverTVParams {
+	    Language: string;
+	    Page: number;
+	    SortBy: string;
+	    IncludeAdult: boolean;
+	    IncludeNullFirstAirDates: boolean;
+	    Timezone: string;
+	    FirstAirDateYear: number;
+	    FirstAirDateGTE: string;
+	    FirstAirDateLTE: string;
+	    AirDateGTE: string;
+	    AirDateLTE: string;
+	    WithGenres: string;
+	    WithoutGenres: string;
+	    WithKeywords: string;
+	    WithCompanies: string;
+	    WithNetworks: number;
+	    WithOriginalLanguage: string;
+	    WithOriginCountry: string;
+	    WithRuntimeGTE: number;
+	    WithRuntimeLTE: number;
+	    WithStatus: string;
+	    WithType: string;
+	    ScreenedTheatrically: boolean;
+	    VoteAverageGTE: number;
+	    VoteCountGTE: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new DiscoverTVParams(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Language = source["Language"];
+	        this.Page = source["Page"];
+	        this.SortBy = source["SortBy"];
+	        this.IncludeAdult = source["IncludeAdult"];
+	        this.IncludeNullFirstAirDates = source["IncludeNullFirstAirDates"];
+	        this.Timezone = source["Timezone"];
+	        this.FirstAirDateYear = source["FirstAirDateYear"];
+	        this.FirstAirDateGTE = source["FirstAirDateGTE"];
+	        this.FirstAirDateLTE = source["FirstAirDateLTE"];
+	        this.AirDateGTE = source["AirDateGTE"];
+	        this.AirDateLTE = source["AirDateLTE"];
+	        this.WithGenres = source["WithGenres"];
+	        this.WithoutGenres = source["WithoutGenres"];
+	        this.WithKeywords = source["WithKeywords"];
+	        this.WithCompanies = source["WithCompanies"];
+	        this.WithNetworks = source["WithNetworks"];
+	        this.WithOriginalLanguage = source["WithOriginalLanguage"];
+	        this.WithOriginCountry = source["WithOriginCountry"];
+	        this.WithRuntimeGTE = source["WithRuntimeGTE"];
+	        this.WithRuntimeLTE = source["WithRuntimeLTE"];
+	        this.WithStatus = source["WithStatus"];
+	        this.WithType = source["WithType"];
+	        this.ScreenedTheatrically = source["ScreenedTheatrically"];
+	        this.VoteAverageGTE = source["VoteAverageGTE"];
+	        this.VoteCountGTE = source["VoteCountGTE"];
+	    }
+	}
+	export class Episode {
+	    id: number;
+	    name: string;
+	    overview: string;
+	    vote_average: number;
+	    vote_count: number;
+	    air_date?: string;
+	    episode_number: number;
+	    episode_type: string;
+	    production_code?: string;
+	    runtime?: number;
+	    season_number: number;
+	    show_id: number;
+	    still_path?: string;
+	    order: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new Episode(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.overview = source["overview"];
+	        this.vote_average = source["vote_average"];
+	        this.vote_count = source["vote_count"];
+	        this.air_date = source["air_date"];
+	        this.episode_number = source["episode_number"];
+	        this.episode_type = source["episode_type"];
+	        this.production_code = source["production_code"];
+	        this.runtime = source["runtime"];
+	        this.season_number = source["season_number"];
+	        this.show_id = source["show_id"];
+	        this.still_path = source["still_path"];
+	        this.order = source["order"];
+	    }
+	}
+	export class EpisodeCreditsResponse {
+	    cast: CastMember[];
+	    crew: CrewMember[];
+	    guest_stars: CastMember[];
+	
+	    static createFrom(source: any = {}) {
+	        return new EpisodeCreditsResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.cast = this.convertValues(source["cast"], CastMember);
+	        this.crew = this.convertValues(source["crew"], CrewMember);
+	        this.guest_stars = this.convertValues(source["guest_stars"], CastMember);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class EpisodeTranslationData {
+	    name: string;
+	    overview: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new EpisodeTranslationData(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.overview = source["overview"];
+	    }
+	}
+	export class Translation_mediajerk_backend_tmdb_EpisodeTranslationData_ {
+	    iso_3166_1: string;
+	    iso_639_1: string;
+	    name: string;
+	    english_name: string;
+	    data: EpisodeTranslationData;
+	
+	    static createFrom(source: any = {}) {
+	        return new Translation_mediajerk_backend_tmdb_EpisodeTranslationData_(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.iso_3166_1 = source["iso_3166_1"];
+	        this.iso_639_1 = source["iso_639_1"];
+	        this.name = source["name"];
+	        this.english_name = source["english_name"];
+	        this.data = this.convertValues(source["data"], EpisodeTranslationData);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class TranslationsResponse_mediajerk_backend_tmdb_EpisodeTranslationData_ {
+	    translations: Translation_mediajerk_backend_tmdb_EpisodeTranslationData_[];
+	
+	    static createFrom(source: any = {}) {
+	        return new TranslationsResponse_mediajerk_backend_tmdb_EpisodeTranslationData_(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.translations = this.convertValues(source["translations"], Translation_mediajerk_backend_tmdb_EpisodeTranslationData_);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ExternalIDsResponse {
+	    id: number;
+	    imdb_id?: string;
+	    tvdb_id?: number;
+	    tvrage_id?: number;
+	    wikidata_id?: string;
+	    freebase_mid?: string;
+	    freebase_id?: string;
+	    facebook_id?: string;
+	    instagram_id?: string;
+	    twitter_id?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ExternalIDsResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.imdb_id = source["imdb_id"];
+	        this.tvdb_id = source["tvdb_id"];
+	        this.tvrage_id = source["tvrage_id"];
+	        this.wikidata_id = source["wikidata_id"];
+	        this.freebase_mid = source["freebase_mid"];
+	        this.freebase_id = source["freebase_id"];
+	        this.facebook_id = source["facebook_id"];
+	        this.instagram_id = source["instagram_id"];
+	        this.twitter_id = source["twitter_id"];
+	    }
+	}
+	export class Image {
+	    aspect_ratio: number;
+	    height: number;
+	    iso_639_1?: string;
+	    file_path: string;
+	    vote_average: number;
+	    vote_count: number;
+	    width: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new Image(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.aspect_ratio = source["aspect_ratio"];
+	        this.height = source["height"];
+	        this.iso_639_1 = source["iso_639_1"];
+	        this.file_path = source["file_path"];
+	        this.vote_average = source["vote_average"];
+	        this.vote_count = source["vote_count"];
+	        this.width = source["width"];
+	    }
+	}
+	export class EpisodeImagesResponse {
+	    stills: Image[];
+	
+	    static createFrom(source: any = {}) {
+	        return new EpisodeImagesResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.stills = this.convertValues(source["stills"], Image);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class EpisodeDetails {
+	    id: number;
+	    name: string;
+	    overview: string;
+	    vote_average: number;
+	    vote_count: number;
+	    air_date?: string;
+	    episode_number: number;
+	    episode_type: string;
+	    production_code?: string;
+	    runtime?: number;
+	    season_number: number;
+	    still_path?: string;
+	    crew: CrewMember[];
+	    guest_stars: CastMember[];
+	    credits?: EpisodeCreditsResponse;
+	    images?: EpisodeImagesResponse;
+	    external_ids?: ExternalIDsResponse;
+	    translations?: TranslationsResponse_mediajerk_backend_tmdb_EpisodeTranslationData_;
+	
+	    static createFrom(source: any = {}) {
+	        return new EpisodeDetails(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.overview = source["overview"];
+	        this.vote_average = source["vote_average"];
+	        this.vote_count = source["vote_count"];
+	        this.air_date = source["air_date"];
+	        this.episode_number = source["episode_number"];
+	        this.episode_type = source["episode_type"];
+	        this.production_code = source["production_code"];
+	        this.runtime = source["runtime"];
+	        this.season_number = source["season_number"];
+	        this.still_path = source["still_path"];
+	        this.crew = this.convertValues(source["crew"], CrewMember);
+	        this.guest_stars = this.convertValues(source["guest_stars"], CastMember);
+	        this.credits = this.convertValues(source["credits"], EpisodeCreditsResponse);
+	        this.images = this.convertValues(source["images"], EpisodeImagesResponse);
+	        this.external_ids = this.convertValues(source["external_ids"], ExternalIDsResponse);
+	        this.translations = this.convertValues(source["translations"], TranslationsResponse_mediajerk_backend_tmdb_EpisodeTranslationData_);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class EpisodeGroup {
+	    description: string;
+	    episode_count: number;
+	    group_count: number;
+	    id: string;
+	    name: string;
+	    // Go type: struct { ID int "json:\"id\""; LogoPath *string "json:\"logo_path\""; Name string "json:\"name\""; OriginCountry string "json:\"origin_country\"" }
+	    network?: any;
+	    type: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new EpisodeGroup(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.description = source["description"];
+	        this.episode_count = source["episode_count"];
+	        this.group_count = source["group_count"];
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.network = this.convertValues(source["network"], Object);
+	        this.type = source["type"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class EpisodeGroupSeason {
+	    id: string;
+	    name: string;
+	    order: number;
+	    episodes: Episode[];
+	
+	    static createFrom(source: any = {}) {
+	        return new EpisodeGroupSeason(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.order = source["order"];
+	        this.episodes = this.convertValues(source["episodes"], Episode);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class EpisodeGroupDetails {
+	    description: string;
+	    id: string;
+	    name: string;
+	    // Go type: struct { ID int "json:\"id\""; LogoPath *string "json:\"logo_path\""; Name string "json:\"name\""; OriginCountry string "json:\"origin_country\"" }
+	    network?: any;
+	    type: number;
+	    groups: EpisodeGroupSeason[];
+	
+	    static createFrom(source: any = {}) {
+	        return new EpisodeGroupDetails(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.description = source["description"];
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.network = this.convertValues(source["network"], Object);
+	        this.type = source["type"];
+	        this.groups = this.convertValues(source["groups"], EpisodeGroupSeason);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class EpisodeGroupList {
+	    results: EpisodeGroup[];
+	    id: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new EpisodeGroupList(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.results = this.convertValues(source["results"], EpisodeGroup);
+	        this.id = source["id"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	
+	
+	export class MultiMedia {
+	    media_type: string;
+	    id: number;
+	    title: string;
+	    original_title: string;
+	    original_language: string;
+	    overview: string;
+	    poster_path?: string;
+	    backdrop_path?: string;
+	    release_date: string;
+	    adult: boolean;
+	    popularity: number;
+	    vote_average: number;
+	    vote_count: number;
+	    genre_ids: number[];
+	    genre_names?: string[];
+	    id: number;
+	    name: string;
+	    original_name: string;
+	    original_language: string;
+	    overview: string;
+	    poster_path?: string;
+	    backdrop_path?: string;
+	    first_air_date: string;
+	    adult: boolean;
+	    popularity: number;
+	    vote_average: number;
+	    vote_count: number;
+	    genre_ids: number[];
+	    origin_country: string[];
+	    genre_names?: string[];
+	    id: number;
+	    name: string;
+	    profile_path?: string;
+	    adult: boolean;
+	    popularity: number;
+	    known_for_department: string;
+	    gender: number;
+	    known_for: MultiMedia[];
+	
+	    static createFrom(source: any = {}) {
+	        return new MultiMedia(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.media_type = source["media_type"];
+	        this.id = source["id"];
+	        this.title = source["title"];
+	        this.original_title = source["original_title"];
+	        this.original_language = source["original_language"];
+	        this.overview = source["overview"];
+	        this.poster_path = source["poster_path"];
+	        this.backdrop_path = source["backdrop_path"];
+	        this.release_date = source["release_date"];
+	        this.adult = source["adult"];
+	        this.popularity = source["popularity"];
+	        this.vote_average = source["vote_average"];
+	        this.vote_count = source["vote_count"];
+	        this.genre_ids = source["genre_ids"];
+	        this.genre_names = source["genre_names"];
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.original_name = source["original_name"];
+	        this.original_language = source["original_language"];
+	        this.overview = source["overview"];
+	        this.poster_path = source["poster_path"];
+	        this.backdrop_path = source["backdrop_path"];
+	        this.first_air_date = source["first_air_date"];
+	        this.adult = source["adult"];
+	        this.popularity = source["popularity"];
+	        this.vote_average = source["vote_average"];
+	        this.vote_count = source["vote_count"];
+	        this.genre_ids = source["genre_ids"];
+	        this.origin_country = source["origin_country"];
+	        this.genre_names = source["genre_names"];
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.profile_path = source["profile_path"];
+	        this.adult = source["adult"];
+	        this.popularity = source["popularity"];
+	        this.known_for_department = source["known_for_department"];
+	        this.gender = source["gender"];
+	        this.known_for = this.convertValues(source["known_for"], MultiMedia);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Person {
+	    id: number;
+	    name: string;
+	    profile_path?: string;
+	    adult: boolean;
+	    popularity: number;
+	    known_for_department: string;
+	    gender: number;
+	    known_for: MultiMedia[];
+	
+	    static createFrom(source: any = {}) {
+	        return new Person(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.profile_path = source["profile_path"];
+	        this.adult = source["adult"];
+	        this.popularity = source["popularity"];
+	        this.known_for_department = source["known_for_department"];
+	        this.gender = source["gender"];
+	        this.known_for = this.convertValues(source["known_for"], MultiMedia);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SeasonResult {
+	    air_date?: string;
+	    episode_count: number;
+	    id: number;
+	    name: string;
+	    overview: string;
+	    poster_path?: string;
+	    season_number: number;
+	    vote_average: number;
+	    show_id: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new SeasonResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.air_date = source["air_date"];
+	        this.episode_count = source["episode_count"];
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.overview = source["overview"];
+	        this.poster_path = source["poster_path"];
+	        this.season_number = source["season_number"];
+	        this.vote_average = source["vote_average"];
+	        this.show_id = source["show_id"];
+	    }
+	}
+	export class TVShow {
+	    id: number;
+	    name: string;
+	    original_name: string;
+	    original_language: string;
+	    overview: string;
+	    poster_path?: string;
+	    backdrop_path?: string;
+	    first_air_date: string;
+	    adult: boolean;
+	    popularity: number;
+	    vote_average: number;
+	    vote_count: number;
+	    genre_ids: number[];
+	    origin_country: string[];
+	    genre_names?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new TVShow(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.original_name = source["original_name"];
+	        this.original_language = source["original_language"];
+	        this.overview = source["overview"];
+	        this.poster_path = source["poster_path"];
+	        this.backdrop_path = source["backdrop_path"];
+	        this.first_air_date = source["first_air_date"];
+	        this.adult = source["adult"];
+	        this.popularity = source["popularity"];
+	        this.vote_average = source["vote_average"];
+	        this.vote_count = source["vote_count"];
+	        this.genre_ids = source["genre_ids"];
+	        this.origin_country = source["origin_country"];
+	        this.genre_names = source["genre_names"];
+	    }
+	}
+	export class FindResponse {
+	    movie_results: Movie[];
+	    tv_results: TVShow[];
+	    tv_season_results: SeasonResult[];
+	    tv_episode_results: Episode[];
+	    person_results: Person[];
+	
+	    static createFrom(source: any = {}) {
+	        return new FindResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.movie_results = this.convertValues(source["movie_results"], Movie);
+	        this.tv_results = this.convertValues(source["tv_results"], TVShow);
+	        this.tv_season_results = this.convertValues(source["tv_season_results"], SeasonResult);
+	        this.tv_episode_results = this.convertValues(source["tv_episode_results"], Episode);
+	        this.person_results = this.convertValues(source["person_results"], Person);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Genre {
+	    id: number;
+	    name: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Genre(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	    }
+	}
+	
+	export class ImagesResponse {
+	    backdrops: Image[];
+	    logos: Image[];
+	    posters: Image[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ImagesResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.backdrops = this.convertValues(source["backdrops"], Image);
+	        this.logos = this.convertValues(source["logos"], Image);
+	        this.posters = this.convertValues(source["posters"], Image);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Keyword {
+	    id: number;
+	    name: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Keyword(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	    }
+	}
+	export class KeywordsResponse {
+	    keywords: Keyword[];
+	
+	    static createFrom(source: any = {}) {
+	        return new KeywordsResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.keywords = this.convertValues(source["keywords"], Keyword);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class WatchProvidersResponse {
+	    results: Record<string, CountryWatchProviders>;
+	
+	    static createFrom(source: any = {}) {
+	        return new WatchProvidersResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.results = this.convertValues(source["results"], CountryWatchProviders, true);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ReleaseDatesResponse {
+	    results: CountryReleaseDates[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ReleaseDatesResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.results = this.convertValues(source["results"], CountryReleaseDates);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class MovieTranslationData {
+	    title: string;
+	    overview: string;
+	    tagline: string;
+	    homepage: string;
+	    runtime: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new MovieTranslationData(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.title = source["title"];
+	        this.overview = source["overview"];
+	        this.tagline = source["tagline"];
+	        this.homepage = source["homepage"];
+	        this.runtime = source["runtime"];
+	    }
+	}
+	export class Translation_mediajerk_backend_tmdb_MovieTranslationData_ {
+	    iso_3166_1: string;
+	    iso_639_1: string;
+	    name: string;
+	    english_name: string;
+	    data: MovieTranslationData;
+	
+	    static createFrom(source: any = {}) {
+	        return new Translation_mediajerk_backend_tmdb_MovieTranslationData_(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.iso_3166_1 = source["iso_3166_1"];
+	        this.iso_639_1 = source["iso_639_1"];
+	        this.name = source["name"];
+	        this.english_name = source["english_name"];
+	        this.data = this.convertValues(source["data"], MovieTranslationData);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class TranslationsResponse_mediajerk_backend_tmdb_MovieTranslationData_ {
+	    translations: Translation_mediajerk_backend_tmdb_MovieTranslationData_[];
+	
+	    static createFrom(source: any = {}) {
+	        return new TranslationsResponse_mediajerk_backend_tmdb_MovieTranslationData_(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.translations = this.convertValues(source["translations"], Translation_mediajerk_backend_tmdb_MovieTranslationData_);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Video {
+	    id: string;
+	    iso_639_1: string;
+	    iso_3166_1: string;
+	    key: string;
+	    name: string;
+	    official: boolean;
+	    published_at: string;
+	    site: string;
+	    size: number;
+	    type: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Video(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.iso_639_1 = source["iso_639_1"];
+	        this.iso_3166_1 = source["iso_3166_1"];
+	        this.key = source["key"];
+	        this.name = source["name"];
+	        this.official = source["official"];
+	        this.published_at = source["published_at"];
+	        this.site = source["site"];
+	        this.size = source["size"];
+	        this.type = source["type"];
+	    }
+	}
+	export class VideosResponse {
+	    results: Video[];
+	
+	    static createFrom(source: any = {}) {
+	        return new VideosResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.results = this.convertValues(source["results"], Video);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SpokenLanguage {
+	    english_name: string;
+	    iso_639_1: string;
+	    name: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new SpokenLanguage(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.english_name = source["english_name"];
+	        this.iso_639_1 = source["iso_639_1"];
+	        this.name = source["name"];
+	    }
+	}
+	export class ProductionCountry {
+	    iso_3166_1: string;
+	    name: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProductionCountry(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.iso_3166_1 = source["iso_3166_1"];
+	        this.name = source["name"];
+	    }
+	}
+	export class ProductionCompany {
+	    id: number;
+	    logo_path?: string;
+	    name: string;
+	    origin_country: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProductionCompany(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.logo_path = source["logo_path"];
+	        this.name = source["name"];
+	        this.origin_country = source["origin_country"];
+	    }
+	}
+	export class MovieDetails {
+	    adult: boolean;
+	    backdrop_path?: string;
+	    belongs_to_collection?: Collection;
+	    budget: number;
+	    genres: Genre[];
+	    homepage?: string;
+	    id: number;
+	    imdb_id?: string;
+	    origin_country: string[];
+	    original_language: string;
+	    original_title: string;
+	    overview?: string;
+	    popularity: number;
+	    poster_path?: string;
+	    production_companies: ProductionCompany[];
+	    production_countries: ProductionCountry[];
+	    release_date: string;
+	    revenue: number;
+	    runtime?: number;
+	    spoken_languages: SpokenLanguage[];
+	    status: string;
+	    tagline?: string;
+	    title: string;
+	    video: boolean;
+	    vote_average: number;
+	    vote_count: number;
+	    videos?: VideosResponse;
+	    images?: ImagesResponse;
+	    credits?: CreditsResponse;
+	    external_ids?: ExternalIDsResponse;
+	    alternative_titles?: AlternativeTitlesResponse;
+	    translations?: TranslationsResponse_mediajerk_backend_tmdb_MovieTranslationData_;
+	    release_dates?: ReleaseDatesResponse;
+	    keywords?: KeywordsResponse;
+	    watch_providers?: WatchProvidersResponse;
+	
+	    static createFrom(source: any = {}) {
+	        return new MovieDetails(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.adult = source["adult"];
+	        this.backdrop_path = source["backdrop_path"];
+	        this.belongs_to_collection = this.convertValues(source["belongs_to_collection"], Collection);
+	        this.budget = source["budget"];
+	        this.genres = this.convertValues(source["genres"], Genre);
+	        this.homepage = source["homepage"];
+	        this.id = source["id"];
+	        this.imdb_id = source["imdb_id"];
+	        this.origin_country = source["origin_country"];
+	        this.original_language = source["original_language"];
+	        this.original_title = source["original_title"];
+	        this.overview = source["overview"];
+	        this.popularity = source["popularity"];
+	        this.poster_path = source["poster_path"];
+	        this.production_companies = this.convertValues(source["production_companies"], ProductionCompany);
+	        this.production_countries = this.convertValues(source["production_countries"], ProductionCountry);
+	        this.release_date = source["release_date"];
+	        this.revenue = source["revenue"];
+	        this.runtime = source["runtime"];
+	        this.spoken_languages = this.convertValues(source["spoken_languages"], SpokenLanguage);
+	        this.status = source["status"];
+	        this.tagline = source["tagline"];
+	        this.title = source["title"];
+	        this.video = source["video"];
+	        this.vote_average = source["vote_average"];
+	        this.vote_count = source["vote_count"];
+	        this.videos = this.convertValues(source["videos"], VideosResponse);
+	        this.images = this.convertValues(source["images"], ImagesResponse);
+	        this.credits = this.convertValues(source["credits"], CreditsResponse);
+	        this.external_ids = this.convertValues(source["external_ids"], ExternalIDsResponse);
+	        this.alternative_titles = this.convertValues(source["alternative_titles"], AlternativeTitlesResponse);
+	        this.translations = this.convertValues(source["translations"], TranslationsResponse_mediajerk_backend_tmdb_MovieTranslationData_);
+	        this.release_dates = this.convertValues(source["release_dates"], ReleaseDatesResponse);
+	        this.keywords = this.convertValues(source["keywords"], KeywordsResponse);
+	        this.watch_providers = this.convertValues(source["watch_providers"], WatchProvidersResponse);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class MovieSearchParams {
+	    Query: string;
+	    IncludeAdult: boolean;
+	    Language: string;
+	    Page: number;
+	    PrimaryReleaseYear: string;
+	    Region: string;
+	    Year: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new MovieSearchParams(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Query = source["Query"];
+	        this.IncludeAdult = source["IncludeAdult"];
+	        this.Language = source["Language"];
+	        this.Page = source["Page"];
+	        this.PrimaryReleaseYear = source["PrimaryReleaseYear"];
+	        this.Region = source["Region"];
+	        this.Year = source["Year"];
+	    }
+	}
+	
+	
+	export class Network {
+	    id: number;
+	    logo_path?: string;
+	    name: string;
+	    origin_country: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Network(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.logo_path = source["logo_path"];
+	        this.name = source["name"];
+	        this.origin_country = source["origin_country"];
+	    }
+	}
+	
+	
+	export class PersonDetails {
+	    adult: boolean;
+	    also_known_as: string[];
+	    biography: string;
+	    birthday?: string;
+	    deathday?: string;
+	    gender: number;
+	    homepage?: string;
+	    id: number;
+	    imdb_id?: string;
+	    known_for_department: string;
+	    name: string;
+	    place_of_birth?: string;
+	    popularity: number;
+	    profile_path?: string;
+	    combined_credits?: CombinedCreditsResponse;
+	    external_ids?: ExternalIDsResponse;
+	
+	    static createFrom(source: any = {}) {
+	        return new PersonDetails(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.adult = source["adult"];
+	        this.also_known_as = source["also_known_as"];
+	        this.biography = source["biography"];
+	        this.birthday = source["birthday"];
+	        this.deathday = source["deathday"];
+	        this.gender = source["gender"];
+	        this.homepage = source["homepage"];
+	        this.id = source["id"];
+	        this.imdb_id = source["imdb_id"];
+	        this.known_for_department = source["known_for_department"];
+	        this.name = source["name"];
+	        this.place_of_birth = source["place_of_birth"];
+	        this.popularity = source["popularity"];
+	        this.profile_path = source["profile_path"];
+	        this.combined_credits = this.convertValues(source["combined_credits"], CombinedCreditsResponse);
+	        this.external_ids = this.convertValues(source["external_ids"], ExternalIDsResponse);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	
+	
+	export class SearchResponse_mediajerk_backend_tmdb_Collection_ {
+	    page: number;
+	    results: Collection[];
+	    total_pages: number;
+	    total_results: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new SearchResponse_mediajerk_backend_tmdb_Collection_(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.page = source["page"];
+	        this.results = this.convertValues(source["results"], Collection);
+	        this.total_pages = source["total_pages"];
+	        this.total_results = source["total_results"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SearchResponse_mediajerk_backend_tmdb_Movie_ {
+	    page: number;
+	    results: Movie[];
+	    total_pages: number;
+	    total_results: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new SearchResponse_mediajerk_backend_tmdb_Movie_(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.page = source["page"];
+	        this.results = this.convertValues(source["results"], Movie);
+	        this.total_pages = source["total_pages"];
+	        this.total_results = source["total_results"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SearchResponse_mediajerk_backend_tmdb_MultiMedia_ {
+	    page: number;
+	    results: MultiMedia[];
+	    total_pages: number;
+	    total_results: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new SearchResponse_mediajerk_backend_tmdb_MultiMedia_(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.page = source["page"];
+	        this.results = this.convertValues(source["results"], MultiMedia);
+	        this.total_pages = source["total_pages"];
+	        this.total_results = source["total_results"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SearchResponse_mediajerk_backend_tmdb_Person_ {
+	    page: number;
+	    results: Person[];
+	    total_pages: number;
+	    total_results: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new SearchResponse_mediajerk_backend_tmdb_Person_(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.page = source["page"];
+	        this.results = this.convertValues(source["results"], Person);
+	        this.total_pages = source["total_pages"];
+	        this.total_results = source["total_results"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SearchResponse_mediajerk_backend_tmdb_TVShow_ {
+	    page: number;
+	    results: TVShow[];
+	    total_pages: number;
+	    total_results: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new SearchResponse_mediajerk_backend_tmdb_TVShow_(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.page = source["page"];
+	        this.results = this.convertValues(source["results"], TVShow);
+	        this.total_pages = source["total_pages"];
+	        this.total_results = source["total_results"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Season {
+	    air_date?: string;
+	    episode_count: number;
+	    id: number;
+	    name: string;
+	    overview: string;
+	    poster_path?: string;
+	    season_number: number;
+	    vote_average: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new Season(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.air_date = source["air_date"];
+	        this.episode_count = source["episode_count"];
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.overview = source["overview"];
+	        this.poster_path = source["poster_path"];
+	        this.season_number = source["season_number"];
+	        this.vote_average = source["vote_average"];
+	    }
+	}
+	
+	
+	export class TVSearchParams {
+	    Query: string;
+	    IncludeAdult: boolean;
+	    Language: string;
+	    Page: number;
+	    FirstAirDateYear: number;
+	    Year: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new TVSearchParams(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Query = source["Query"];
+	        this.IncludeAdult = source["IncludeAdult"];
+	        this.Language = source["Language"];
+	        this.Page = source["Page"];
+	        this.FirstAirDateYear = source["FirstAirDateYear"];
+	        this.Year = source["Year"];
+	    }
+	}
+	export class TVSeasonDetails {
+	    id: number;
+	    air_date?: string;
+	    episodes: Episode[];
+	    name: string;
+	    overview: string;
+	    poster_path?: string;
+	    season_number: number;
+	    vote_average: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new TVSeasonDetails(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.air_date = source["air_date"];
+	        this.episodes = this.convertValues(source["episodes"], Episode);
+	        this.name = source["name"];
+	        this.overview = source["overview"];
+	        this.poster_path = source["poster_path"];
+	        this.season_number = source["season_number"];
+	        this.vote_average = source["vote_average"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class TVTranslationData {
+	    name: string;
+	    overview: string;
+	    tagline: string;
+	    homepage: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new TVTranslationData(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.overview = source["overview"];
+	        this.tagline = source["tagline"];
+	        this.homepage = source["homepage"];
+	    }
+	}
+	export class Translation_mediajerk_backend_tmdb_TVTranslationData_ {
+	    iso_3166_1: string;
+	    iso_639_1: string;
+	    name: string;
+	    english_name: string;
+	    data: TVTranslationData;
+	
+	    static createFrom(source: any = {}) {
+	        return new Translation_mediajerk_backend_tmdb_TVTranslationData_(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.iso_3166_1 = source["iso_3166_1"];
+	        this.iso_639_1 = source["iso_639_1"];
+	        this.name = source["name"];
+	        this.english_name = source["english_name"];
+	        this.data = this.convertValues(source["data"], TVTranslationData);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class TranslationsResponse_mediajerk_backend_tmdb_TVTranslationData_ {
+	    translations: Translation_mediajerk_backend_tmdb_TVTranslationData_[];
+	
+	    static createFrom(source: any = {}) {
+	        return new TranslationsResponse_mediajerk_backend_tmdb_TVTranslationData_(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.translations = this.convertValues(source["translations"], Translation_mediajerk_backend_tmdb_TVTranslationData_);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class TVSeriesDetails {
+	    adult: boolean;
+	    backdrop_path?: string;
+	    created_by: CreatedBy[];
+	    episode_run_time: number[];
+	    first_air_date?: string;
+	    genres: Genre[];
+	    homepage: string;
+	    id: number;
+	    in_production: boolean;
+	    languages: string[];
+	    last_air_date?: string;
+	    last_episode_to_air?: Episode;
+	    name: string;
+	    next_episode_to_air?: Episode;
+	    networks: Network[];
+	    number_of_episodes: number;
+	    number_of_seasons: number;
+	    origin_country: string[];
+	    original_language: string;
+	    original_name: string;
+	    overview: string;
+	    popularity: number;
+	    poster_path?: string;
+	    production_companies: ProductionCompany[];
+	    production_countries: ProductionCountry[];
+	    seasons: Season[];
+	    spoken_languages: SpokenLanguage[];
+	    status: string;
+	    tagline: string;
+	    type: string;
+	    vote_average: number;
+	    vote_count: number;
+	    videos?: VideosResponse;
+	    images?: ImagesResponse;
+	    credits?: CreditsResponse;
+	    episode_groups?: EpisodeGroupList;
+	    external_ids?: ExternalIDsResponse;
+	    alternative_titles?: AlternativeTitlesResponse;
+	    translations?: TranslationsResponse_mediajerk_backend_tmdb_TVTranslationData_;
+	    content_ratings?: ContentRatingsResponse;
+	    keywords?: KeywordsResponse;
+	    watch_providers?: WatchProvidersResponse;
+	
+	    static createFrom(source: any = {}) {
+	        return new TVSeriesDetails(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.adult = source["adult"];
+	        this.backdrop_path = source["backdrop_path"];
+	        this.created_by = this.convertValues(source["created_by"], CreatedBy);
+	        this.episode_run_time = source["episode_run_time"];
+	        this.first_air_date = source["first_air_date"];
+	        this.genres = this.convertValues(source["genres"], Genre);
+	        this.homepage = source["homepage"];
+	        this.id = source["id"];
+	        this.in_production = source["in_production"];
+	        this.languages = source["languages"];
+	        this.last_air_date = source["last_air_date"];
+	        this.last_episode_to_air = this.convertValues(source["last_episode_to_air"], Episode);
+	        this.name = source["name"];
+	        this.next_episode_to_air = this.convertValues(source["next_episode_to_air"], Episode);
+	        this.networks = this.convertValues(source["networks"], Network);
+	        this.number_of_episodes = source["number_of_episodes"];
+	        this.number_of_seasons = source["number_of_seasons"];
+	        this.origin_country = source["origin_country"];
+	        this.original_language = source["original_language"];
+	        this.original_name = source["original_name"];
+	        this.overview = source["overview"];
+	        this.popularity = source["popularity"];
+	        this.poster_path = source["poster_path"];
+	        this.production_companies = this.convertValues(source["production_companies"], ProductionCompany);
+	        this.production_countries = this.convertValues(source["production_countries"], ProductionCountry);
+	        this.seasons = this.convertValues(source["seasons"], Season);
+	        this.spoken_languages = this.convertValues(source["spoken_languages"], SpokenLanguage);
+	        this.status = source["status"];
+	        this.tagline = source["tagline"];
+	        this.type = source["type"];
+	        this.vote_average = source["vote_average"];
+	        this.vote_count = source["vote_count"];
+	        this.videos = this.convertValues(source["videos"], VideosResponse);
+	        this.images = this.convertValues(source["images"], ImagesResponse);
+	        this.credits = this.convertValues(source["credits"], CreditsResponse);
+	        this.episode_groups = this.convertValues(source["episode_groups"], EpisodeGroupList);
+	        this.external_ids = this.convertValues(source["external_ids"], ExternalIDsResponse);
+	        this.alternative_titles = this.convertValues(source["alternative_titles"], AlternativeTitlesResponse);
+	        this.translations = this.convertValues(source["translations"], TranslationsResponse_mediajerk_backend_tmdb_TVTranslationData_);
+	        this.content_ratings = this.convertValues(source["content_ratings"], ContentRatingsResponse);
+	        this.keywords = this.convertValues(source["keywords"], KeywordsResponse);
+	        this.watch_providers = this.convertValues(source["watch_providers"], WatchProvidersResponse);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	
+	
+	
+	
+	
+	
+	export class TrendingParams {
+	    Language: string;
+	    Page: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new TrendingParams(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Language = source["Language"];
+	        this.Page = source["Page"];
+	    }
+	}
+	
+	
+	
 
 }
 
@@ -25,11 +25,13 @@ func main() {
 		Height:    768,
 		Frameless: true,
 		AssetServer: &assetserver.Options{
-			Assets: assets,
+			Assets:  assets,
+			Handler: app.artworkHandler(),
 		},
 		// BackgroundColour: &options.RGBA{R: 27, G: 38, B: 54, A: 1},
 		BackgroundColour: &options.RGBA{R: 0, G: 0, B: 0, A: 0},
 		OnStartup:        app.startup,
+		OnShutdown:       app.shutdown,
 		Bind: []interface{}{
 			app,
 		},